@@ -3,6 +3,8 @@ module github.com/lucas-de-lima/signet-go
 go 1.24.3
 
 require (
+	filippo.io/edwards25519 v1.1.0
+	github.com/cloudflare/circl v1.6.3
 	github.com/prometheus/client_golang v1.22.0
 	google.golang.org/grpc v1.73.0
 	google.golang.org/protobuf v1.36.6
@@ -2,8 +2,10 @@ package grpcinterceptor
 
 import (
 	"context"
+	"errors"
 	"log"
 
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
 	"github.com/lucas-de-lima/signet-go/signet"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -29,6 +31,29 @@ import (
 //	    ),
 //	)
 func GRPCAuthInterceptor(keyResolver signet.KeyResolverFunc, options ...signet.ValidationOption) grpc.UnaryServerInterceptor {
+	return newAuthInterceptor(func(ctx context.Context, tokenBytes []byte) (*signetv1.SignetPayload, error) {
+		return signet.Parse(ctx, tokenBytes, keyResolver, options...)
+	})
+}
+
+// GRPCAuthInterceptorWithValidator é equivalente a GRPCAuthInterceptor, mas valida os tokens
+// com um signet.Validator pré-configurado, cuja configuração já foi verificada na inicialização.
+//
+// Exemplo de uso:
+//
+//	validator, err := signet.NewValidator(resolver, signet.WithAudience("api-backend"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	server := grpc.NewServer(
+//	    grpc.UnaryInterceptor(grpcinterceptor.GRPCAuthInterceptorWithValidator(validator)),
+//	)
+func GRPCAuthInterceptorWithValidator(validator *signet.Validator) grpc.UnaryServerInterceptor {
+	return newAuthInterceptor(validator.Validate)
+}
+
+// newAuthInterceptor constrói o interceptor a partir da função de validação de tokens.
+func newAuthInterceptor(validate func(ctx context.Context, tokenBytes []byte) (*signetv1.SignetPayload, error)) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -46,18 +71,24 @@ func GRPCAuthInterceptor(keyResolver signet.KeyResolverFunc, options ...signet.V
 		}
 		tokenBytes := []byte(tokens[0])
 
-		// Valida o token usando signet.Parse com resolução dinâmica de chave
-		payload, err := signet.Parse(ctx, tokenBytes, keyResolver, options...)
+		// Valida o token com resolução dinâmica de chave
+		payload, err := validate(ctx, tokenBytes)
 		if err != nil {
-			// Mapeia erros sentinela para status gRPC apropriados
-			switch err {
-			case signet.ErrInvalidSignature, signet.ErrInvalidPayload:
+			// Mapeia erros sentinela (possivelmente envolvidos em *signet.ValidationError)
+			// para status gRPC apropriados
+			switch {
+			case isAny(err, invalidTokenErrors):
 				return nil, status.Error(codes.Unauthenticated, "token inválido ou corrompido")
-			case signet.ErrTokenExpired, signet.ErrAudienceMismatch, signet.ErrMissingRequiredRole, signet.ErrTokenRevoked:
+			case isAny(err, unauthorizedTokenErrors):
 				return nil, status.Error(codes.PermissionDenied, "token não autorizado: "+err.Error())
 			default:
 				// Logar o erro inesperado no servidor para observabilidade.
-				log.Printf("ERRO: erro de autenticação inesperado no interceptor Signet: %v", err)
+				var verr *signet.ValidationError
+				if errors.As(err, &verr) {
+					log.Printf("ERRO: erro de autenticação inesperado no interceptor Signet: reason=%s kid=%s: %v", verr.Reason, verr.KeyID, err)
+				} else {
+					log.Printf("ERRO: erro de autenticação inesperado no interceptor Signet: %v", err)
+				}
 				return nil, status.Error(codes.Unauthenticated, "falha de autenticação interna")
 			}
 		}
@@ -67,3 +98,46 @@ func GRPCAuthInterceptor(keyResolver signet.KeyResolverFunc, options ...signet.V
 		return handler(ctx, req)
 	}
 }
+
+// invalidTokenErrors são falhas de integridade ou formato do token (codes.Unauthenticated).
+// ErrIssuerMismatch é verificado antes da assinatura, portanto o emissor não é confiável e
+// não é ecoado ao cliente.
+var invalidTokenErrors = []error{
+	signet.ErrInvalidSignature,
+	signet.ErrInvalidPayload,
+	signet.ErrNonCanonicalEncoding,
+	signet.ErrInvalidTokenEncoding,
+	signet.ErrDecryptionFailed,
+	signet.ErrUnsupportedVersion,
+	signet.ErrUnknownKeyID,
+	signet.ErrUnsupportedAlgorithm,
+	signet.ErrAlgorithmMismatch,
+	signet.ErrHMACNotAllowed,
+	signet.ErrHybridSignatureRequired,
+	signet.ErrInsufficientSignatures,
+	signet.ErrIssuerMismatch,
+}
+
+// unauthorizedTokenErrors são falhas de validação de claims de um token íntegro (codes.PermissionDenied).
+var unauthorizedTokenErrors = []error{
+	signet.ErrTokenExpired,
+	signet.ErrTokenNotYetValid,
+	signet.ErrTokenNotBefore,
+	signet.ErrTokenTooOld,
+	signet.ErrLifetimeTooLong,
+	signet.ErrAudienceMismatch,
+	signet.ErrMissingRequiredRole,
+	signet.ErrTokenRevoked,
+	signet.ErrTokenReplayed,
+	signet.ErrExtensionMismatch,
+}
+
+// isAny indica se err envolve algum dos erros alvo.
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package grpcinterceptor
 import (
 	"context"
 	"crypto/ed25519"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,6 +53,8 @@ func TestGRPCAuthInterceptor_Fails(t *testing.T) {
 	exp := iat + 1 // expira há ~99 segundos
 	expiredToken, _ := signet.NewPayload().WithIssuedAt(iat).WithExpiration(exp).WithKeyID("v1").Sign(priv)
 	wrongRoleToken, _ := signet.NewPayload().WithRole("user").WithKeyID("v1").Sign(priv)
+	oldToken, _ := signet.NewPayload().WithIssuedAt(iat).WithExpiration(iat + 3600).WithKeyID("v1").Sign(priv)
+	foreignIssuerToken, _ := signet.NewPayload().WithIssuer("atacante.exemplo").WithKeyID("v1").Sign(priv)
 
 	testCases := []struct {
 		name         string
@@ -63,6 +66,9 @@ func TestGRPCAuthInterceptor_Fails(t *testing.T) {
 		{"Token corrompido", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", "corrompido")), nil, codes.Unauthenticated},
 		{"Token expirado", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", string(expiredToken))), nil, codes.PermissionDenied},
 		{"Role incorreto", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", string(wrongRoleToken))), []signet.ValidationOption{signet.RequireRole("admin")}, codes.PermissionDenied},
+		{"Token antigo (erro envolvido)", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", string(oldToken))), []signet.ValidationOption{signet.WithMaxAge(time.Minute)}, codes.PermissionDenied},
+		{"Versão não suportada (erro envolvido)", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", string(oldToken))), []signet.ValidationOption{signet.WithAcceptedVersions(2)}, codes.Unauthenticated},
+		{"Emissor não aceito (antes da assinatura)", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", string(foreignIssuerToken))), []signet.ValidationOption{signet.WithIssuer("auth.exemplo.com")}, codes.Unauthenticated},
 	}
 
 	for _, tc := range testCases {
@@ -72,6 +78,43 @@ func TestGRPCAuthInterceptor_Fails(t *testing.T) {
 			if status.Code(err) != tc.expectedCode {
 				t.Errorf("esperava código %v, mas obteve %v", tc.expectedCode, status.Code(err))
 			}
+			// Dados não verificados do token não são ecoados ao cliente
+			if strings.Contains(status.Convert(err).Message(), "atacante") {
+				t.Errorf("mensagem ecoa o emissor não verificado: %q", status.Convert(err).Message())
+			}
+		})
+	}
+}
+
+func TestGRPCAuthInterceptorWithValidator(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := signet.KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	validator, err := signet.NewValidator(keyResolver, signet.WithAudience("api-backend"))
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	validToken, _ := signet.NewPayload().WithSubject("user-1").WithAudience("api-backend").Sign(priv)
+	wrongAudience, _ := signet.NewPayload().WithAudience("outra").Sign(priv)
+
+	testCases := []struct {
+		name         string
+		token        []byte
+		expectedCode codes.Code
+	}{
+		{"Token válido", validToken, codes.OK},
+		{"Audiência incorreta", wrongAudience, codes.PermissionDenied},
+		{"Token corrompido", []byte("corrompido"), codes.Unauthenticated},
+	}
+	interceptor := GRPCAuthInterceptorWithValidator(validator)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization-bin", string(tc.token)))
+			_, err := interceptor(ctx, nil, nil, handlerFake)
+			if status.Code(err) != tc.expectedCode {
+				t.Errorf("esperava código %v, mas obteve %v", tc.expectedCode, status.Code(err))
+			}
 		})
 	}
 }
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"sync"
+)
+
+// AlgEd25519 é o identificador do algoritmo Ed25519 (RFC 8032), padrão da especificação v1.0.
+const AlgEd25519 = "Ed25519"
+
+var (
+	ErrUnsupportedAlgorithm       = errors.New("algoritmo de assinatura não suportado")
+	ErrAlgorithmMismatch          = errors.New("algoritmo declarado no token não corresponde ao algoritmo da chave")
+	ErrAlgorithmAlreadyRegistered = errors.New("algoritmo de assinatura já registrado")
+	ErrInvalidAlgorithm           = errors.New("algoritmo de assinatura inválido: nulo ou sem identificador")
+	ErrPublicKeyEncoding          = errors.New("algoritmo sem codificação canônica de chave pública")
+)
+
+// Algorithm define o contrato de um algoritmo de assinatura plugável.
+// Implementações DEVEM rejeitar chaves de tipo diferente do esperado com
+// ErrInvalidPrivateKey/ErrInvalidPublicKey e assinaturas inválidas com ErrVerificationFailed.
+type Algorithm interface {
+	// ID retorna o identificador canônico transportado no campo alg do SignetToken.
+	ID() string
+	// Sign gera a assinatura dos dados com a chave privada fornecida.
+	Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error)
+	// Verify verifica a assinatura dos dados com a chave pública fornecida.
+	Verify(publicKey crypto.PublicKey, data, signature []byte) error
+}
+
+// PublicKeyEncoder é implementado opcionalmente por algoritmos cujas chaves públicas não são
+// []byte: retorna uma codificação canônica da chave, idêntica para toda representação
+// aceita por Verify, usada para reconhecer a mesma chave registrada sob kids distintos.
+type PublicKeyEncoder interface {
+	EncodePublicKey(publicKey crypto.PublicKey) ([]byte, error)
+}
+
+// Registry mantém os algoritmos de assinatura disponíveis, indexados pelo identificador.
+// É seguro para uso concorrente.
+type Registry struct {
+	mu         sync.RWMutex
+	algorithms map[string]Algorithm
+}
+
+// NewRegistry cria um registro contendo os algoritmos fornecidos.
+func NewRegistry(algorithms ...Algorithm) *Registry {
+	r := &Registry{algorithms: make(map[string]Algorithm, len(algorithms))}
+	for _, alg := range algorithms {
+		r.algorithms[alg.ID()] = alg
+	}
+	return r
+}
+
+// Register adiciona um algoritmo ao registro.
+// Um identificador já registrado não pode ser sobrescrito.
+func (r *Registry) Register(alg Algorithm) error {
+	if alg == nil || alg.ID() == "" {
+		return ErrInvalidAlgorithm
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.algorithms[alg.ID()]; exists {
+		return ErrAlgorithmAlreadyRegistered
+	}
+	r.algorithms[alg.ID()] = alg
+	return nil
+}
+
+// Lookup retorna o algoritmo registrado para o identificador fornecido.
+func (r *Registry) Lookup(id string) (Algorithm, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	alg, ok := r.algorithms[id]
+	if !ok {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	return alg, nil
+}
+
+// Sign assina os dados com o algoritmo identificado por id.
+func (r *Registry) Sign(id string, privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	alg, err := r.Lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	return alg.Sign(privateKey, data)
+}
+
+// Verify verifica a assinatura com o algoritmo declarado pelo token (tokenAlg).
+// keyAlg é o algoritmo para o qual a chave pública foi registrada: se for diferente
+// de tokenAlg, a verificação é recusada com ErrAlgorithmMismatch antes de qualquer
+// operação criptográfica, impedindo ataques de confusão de algoritmo.
+func (r *Registry) Verify(tokenAlg, keyAlg string, publicKey crypto.PublicKey, data, signature []byte) error {
+	if tokenAlg != keyAlg {
+		return ErrAlgorithmMismatch
+	}
+	alg, err := r.Lookup(tokenAlg)
+	if err != nil {
+		return err
+	}
+	return alg.Verify(publicKey, data, signature)
+}
+
+// EncodePublicKey retorna a codificação canônica da chave pública para o algoritmo id:
+// a de PublicKeyEncoder, se implementado, ou a própria chave, se for []byte.
+// Caso contrário, retorna ErrPublicKeyEncoding.
+func (r *Registry) EncodePublicKey(id string, publicKey crypto.PublicKey) ([]byte, error) {
+	alg, err := r.Lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	if encoder, ok := alg.(PublicKeyEncoder); ok {
+		return encoder.EncodePublicKey(publicKey)
+	}
+	if key, ok := publicKey.([]byte); ok {
+		return key, nil
+	}
+	return nil, ErrPublicKeyEncoding
+}
+
+var defaultRegistry = NewRegistry(Ed25519, ES256, ES384, MLDSA65, HS256)
+
+// DefaultRegistry retorna o registro global usado pelo pacote signet.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// RegisterAlgorithm adiciona um algoritmo ao registro global.
+func RegisterAlgorithm(alg Algorithm) error {
+	return defaultRegistry.Register(alg)
+}
+
+// Ed25519 é a implementação de Algorithm para assinaturas Ed25519.
+var Ed25519 Algorithm = ed25519Algorithm{}
+
+type ed25519Algorithm struct{}
+
+func (ed25519Algorithm) ID() string { return AlgEd25519 }
+
+func (ed25519Algorithm) Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	priv, ok := privateKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidPrivateKey
+	}
+	return Sign(priv, data)
+}
+
+func (ed25519Algorithm) Verify(publicKey crypto.PublicKey, data, signature []byte) error {
+	pub, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		return ErrInvalidPublicKey
+	}
+	return Verify(pub, data, signature)
+}
+
+func (ed25519Algorithm) EncodePublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	pub, ok := publicKey.(ed25519.PublicKey)
+	if !ok || len(pub) != ed25519.PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	return pub, nil
+}
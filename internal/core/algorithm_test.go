@@ -0,0 +1,127 @@
+package core
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+)
+
+// fakeAlgorithm é um algoritmo de teste que aceita qualquer chave do tipo string.
+type fakeAlgorithm struct{ id string }
+
+func (f fakeAlgorithm) ID() string { return f.id }
+
+func (f fakeAlgorithm) Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	return []byte(f.id), nil
+}
+
+func (f fakeAlgorithm) Verify(publicKey crypto.PublicKey, data, signature []byte) error {
+	if string(signature) != f.id {
+		return ErrVerificationFailed
+	}
+	return nil
+}
+
+// TestRegistryRegisterAndLookup garante o registro e a busca de algoritmos por identificador.
+func TestRegistryRegisterAndLookup(t *testing.T) {
+	r := NewRegistry(Ed25519)
+	if _, err := r.Lookup(AlgEd25519); err != nil {
+		t.Fatalf("Ed25519 deveria estar registrado: %v", err)
+	}
+	if _, err := r.Lookup("fake"); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("esperado ErrUnsupportedAlgorithm, obteve: %v", err)
+	}
+	if err := r.Register(fakeAlgorithm{id: "fake"}); err != nil {
+		t.Fatalf("erro ao registrar algoritmo: %v", err)
+	}
+	if _, err := r.Lookup("fake"); err != nil {
+		t.Errorf("algoritmo registrado não encontrado: %v", err)
+	}
+	if err := r.Register(fakeAlgorithm{id: "fake"}); !errors.Is(err, ErrAlgorithmAlreadyRegistered) {
+		t.Errorf("esperado ErrAlgorithmAlreadyRegistered, obteve: %v", err)
+	}
+	if err := r.Register(fakeAlgorithm{}); !errors.Is(err, ErrInvalidAlgorithm) {
+		t.Errorf("esperado ErrInvalidAlgorithm, obteve: %v", err)
+	}
+}
+
+// TestRegistryVerifyAlgorithmMismatch garante que uma chave registrada para um algoritmo
+// não é usada para verificar um token que declara outro.
+func TestRegistryVerifyAlgorithmMismatch(t *testing.T) {
+	r := NewRegistry(Ed25519, fakeAlgorithm{id: "fake"})
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	data := []byte("dados")
+	sig, err := r.Sign(AlgEd25519, priv, data)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	if err := r.Verify(AlgEd25519, AlgEd25519, pub, data, sig); err != nil {
+		t.Errorf("verificação falhou no happy path: %v", err)
+	}
+	// Token declara "fake", mas a chave foi registrada para Ed25519
+	if err := r.Verify("fake", AlgEd25519, pub, data, []byte("fake")); !errors.Is(err, ErrAlgorithmMismatch) {
+		t.Errorf("esperado ErrAlgorithmMismatch, obteve: %v", err)
+	}
+	// Algoritmo desconhecido
+	if err := r.Verify("none", "none", nil, data, nil); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("esperado ErrUnsupportedAlgorithm, obteve: %v", err)
+	}
+}
+
+// TestEd25519AlgorithmKeyTypes garante que o algoritmo Ed25519 rejeita chaves de outros tipos.
+func TestEd25519AlgorithmKeyTypes(t *testing.T) {
+	data := []byte("dados")
+	if _, err := Ed25519.Sign("não é uma chave", data); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("esperado ErrInvalidPrivateKey, obteve: %v", err)
+	}
+	if err := Ed25519.Verify("não é uma chave", data, make([]byte, ed25519.SignatureSize)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+}
+
+// TestRegistryEncodePublicKey garante uma codificação única por chave, independente da
+// representação, e a recusa explícita de chaves sem codificação canônica.
+func TestRegistryEncodePublicKey(t *testing.T) {
+	r := NewRegistry(Ed25519, ES256, MLDSA65, fakeAlgorithm{id: "fake"})
+	edPub, _, _ := ed25519.GenerateKey(nil)
+	ecPriv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ecCopy := ecPriv.PublicKey
+	pqPub, _, _ := mldsa65.GenerateKey(nil)
+
+	testCases := []struct {
+		name  string
+		alg   string
+		first crypto.PublicKey
+		other crypto.PublicKey
+	}{
+		{"Ed25519", AlgEd25519, edPub, append(ed25519.PublicKey(nil), edPub...)},
+		{"ECDSA em ponteiros distintos", AlgES256, &ecPriv.PublicKey, &ecCopy},
+		{"ML-DSA-65 como estrutura e como bytes", AlgMLDSA65, pqPub, pqPub.Bytes()},
+		{"Algoritmo sem codificador com chave []byte", "fake", []byte("chave"), []byte("chave")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			first, err := r.EncodePublicKey(tc.alg, tc.first)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			other, err := r.EncodePublicKey(tc.alg, tc.other)
+			if err != nil || !bytes.Equal(first, other) {
+				t.Errorf("representações da mesma chave deveriam ter a mesma codificação: %x, %x, %v", first, other, err)
+			}
+		})
+	}
+	if _, err := r.EncodePublicKey("fake", "chave opaca"); !errors.Is(err, ErrPublicKeyEncoding) {
+		t.Errorf("esperado ErrPublicKeyEncoding, obteve: %v", err)
+	}
+	if _, err := r.EncodePublicKey(AlgES256, edPub); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// MaxSigningContextSize é o tamanho máximo, em bytes, de um contexto de assinatura.
+const MaxSigningContextSize = 255
+
+// signingContextPrefix identifica mensagens com separação de domínio, distinguindo-as
+// de bytes protobuf crus assinados por outros sistemas com a mesma chave.
+const signingContextPrefix = "signet-ctx-v1\x00"
+
+// versionedMessagePrefix identifica mensagens que vinculam a versão do formato do token
+// à assinatura, impedindo que um token seja reapresentado sob outra versão.
+const versionedMessagePrefix = "signet-msg\x00"
+
+// ErrInvalidSigningContext indica um contexto de assinatura maior que MaxSigningContextSize.
+var ErrInvalidSigningContext = errors.New("contexto de assinatura excede o tamanho máximo")
+
+// SigningMessage retorna a mensagem efetivamente assinada para os dados, o contexto e a versão
+// do formato do token fornecidos.
+//
+// Com versão 0 (tokens legados, sem o campo version), a versão não é vinculada: com contexto
+// vazio, retorna os próprios dados; caso contrário, prefixo || len(contexto) || contexto || dados.
+// Com versão 1 ou superior, retorna prefixo || versão (4 bytes, big-endian) || len(contexto) ||
+// contexto || dados, de modo que alterar a versão declarada invalida a assinatura.
+// Os prefixos de tamanho tornam a codificação injetiva: contextos distintos nunca produzem a
+// mesma mensagem. A separação é aplicada por prefixo explícito, e não via Ed25519ctx, para
+// funcionar igualmente com todos os algoritmos e com assinadores externos (KMS/HSM).
+func SigningMessage(version uint32, signingContext string, data []byte) ([]byte, error) {
+	if len(signingContext) > MaxSigningContextSize {
+		return nil, ErrInvalidSigningContext
+	}
+	if version == 0 && signingContext == "" {
+		return data, nil
+	}
+	if data == nil {
+		return nil, ErrNilData
+	}
+	if version == 0 {
+		msg := make([]byte, 0, len(signingContextPrefix)+1+len(signingContext)+len(data))
+		msg = append(msg, signingContextPrefix...)
+		msg = append(msg, byte(len(signingContext)))
+		msg = append(msg, signingContext...)
+		return append(msg, data...), nil
+	}
+	msg := make([]byte, 0, len(versionedMessagePrefix)+4+1+len(signingContext)+len(data))
+	msg = append(msg, versionedMessagePrefix...)
+	msg = binary.BigEndian.AppendUint32(msg, version)
+	msg = append(msg, byte(len(signingContext)))
+	msg = append(msg, signingContext...)
+	return append(msg, data...), nil
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestSigningMessage garante a compatibilidade sem contexto e a separação entre contextos distintos.
+func TestSigningMessage(t *testing.T) {
+	data := []byte("payload")
+	raw, err := SigningMessage(0, "", data)
+	if err != nil || !bytes.Equal(raw, data) {
+		t.Errorf("contexto vazio deveria retornar os dados originais, obteve %q, %v", raw, err)
+	}
+	// "ab" + "c..." e "a" + "bc..." não podem colidir
+	m1, _ := SigningMessage(0, "ab", []byte("cpayload"))
+	m2, _ := SigningMessage(0, "a", []byte("bcpayload"))
+	if bytes.Equal(m1, m2) {
+		t.Error("contextos distintos produziram a mesma mensagem")
+	}
+	if _, err := SigningMessage(0, strings.Repeat("x", MaxSigningContextSize+1), data); !errors.Is(err, ErrInvalidSigningContext) {
+		t.Errorf("esperado ErrInvalidSigningContext, obteve: %v", err)
+	}
+}
+
+// TestSigningMessageCrossContext garante que uma assinatura não é válida em outro contexto.
+func TestSigningMessageCrossContext(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	data := []byte("payload")
+	staging, _ := SigningMessage(0, "signet.v1:staging", data)
+	production, _ := SigningMessage(0, "signet.v1:production", data)
+	sig, _ := Sign(priv, staging)
+	if err := Verify(pub, staging, sig); err != nil {
+		t.Errorf("verificação falhou no mesmo contexto: %v", err)
+	}
+	if err := Verify(pub, production, sig); !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf("esperado ErrVerificationFailed em outro contexto, obteve: %v", err)
+	}
+	if err := Verify(pub, data, sig); !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf("esperado ErrVerificationFailed sem contexto, obteve: %v", err)
+	}
+}
+
+// TestSigningMessageVersion garante que a versão do formato é vinculada à mensagem assinada.
+func TestSigningMessageVersion(t *testing.T) {
+	data := []byte("payload")
+	legacy, _ := SigningMessage(0, "", data)
+	v1, err := SigningMessage(1, "", data)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	v2, _ := SigningMessage(2, "", data)
+	v1ctx, _ := SigningMessage(1, "signet.v1:staging", data)
+	legacyCtx, _ := SigningMessage(0, "signet.v1:staging", data)
+	messages := [][]byte{legacy, v1, v2, v1ctx, legacyCtx}
+	for i := range messages {
+		for j := i + 1; j < len(messages); j++ {
+			if bytes.Equal(messages[i], messages[j]) {
+				t.Errorf("mensagens %d e %d colidiram: %q", i, j, messages[i])
+			}
+		}
+	}
+	if _, err := SigningMessage(1, strings.Repeat("x", MaxSigningContextSize+1), data); !errors.Is(err, ErrInvalidSigningContext) {
+		t.Errorf("esperado ErrInvalidSigningContext, obteve: %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"math/big"
+)
+
+// Identificadores dos algoritmos ECDSA suportados.
+const (
+	// AlgES256 identifica ECDSA sobre a curva P-256 com SHA-256.
+	AlgES256 = "ES256"
+	// AlgES384 identifica ECDSA sobre a curva P-384 com SHA-384.
+	AlgES384 = "ES384"
+)
+
+var (
+	// ES256 é a implementação de Algorithm para ECDSA P-256 com SHA-256.
+	ES256 Algorithm = ecdsaAlgorithm{id: AlgES256, curve: elliptic.P256(), hash: crypto.SHA256}
+	// ES384 é a implementação de Algorithm para ECDSA P-384 com SHA-384.
+	ES384 Algorithm = ecdsaAlgorithm{id: AlgES384, curve: elliptic.P384(), hash: crypto.SHA384}
+)
+
+// ECDSAAlgorithmForCurve retorna o identificador do algoritmo associado à curva fornecida.
+func ECDSAAlgorithmForCurve(curve elliptic.Curve) (string, error) {
+	switch curve {
+	case elliptic.P256():
+		return AlgES256, nil
+	case elliptic.P384():
+		return AlgES384, nil
+	}
+	return "", ErrUnsupportedAlgorithm
+}
+
+// ecdsaAlgorithm implementa ECDSA com assinaturas de tamanho fixo (r||s, big-endian)
+// e normalização low-S, tornando a assinatura não maleável.
+type ecdsaAlgorithm struct {
+	id    string
+	curve elliptic.Curve
+	hash  crypto.Hash
+}
+
+func (a ecdsaAlgorithm) ID() string { return a.id }
+
+// scalarSize retorna o tamanho, em bytes, de cada componente (r, s) da assinatura.
+func (a ecdsaAlgorithm) scalarSize() int {
+	return (a.curve.Params().BitSize + 7) / 8
+}
+
+func (a ecdsaAlgorithm) digest(data []byte) []byte {
+	if a.hash == crypto.SHA384 {
+		sum := sha512.Sum384(data)
+		return sum[:]
+	}
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+func (a ecdsaAlgorithm) Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	priv, ok := privateKey.(*ecdsa.PrivateKey)
+	if !ok || priv == nil || priv.Curve != a.curve {
+		return nil, ErrInvalidPrivateKey
+	}
+	if data == nil {
+		return nil, ErrNilData
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, priv, a.digest(data))
+	if err != nil {
+		return nil, err
+	}
+	return a.encode(r, s), nil
+}
+
+func (a ecdsaAlgorithm) Verify(publicKey crypto.PublicKey, data, signature []byte) error {
+	pub, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok || pub == nil || pub.Curve != a.curve {
+		return ErrInvalidPublicKey
+	}
+	if data == nil {
+		return ErrNilData
+	}
+	size := a.scalarSize()
+	if len(signature) != 2*size {
+		return ErrInvalidSignature
+	}
+	r := new(big.Int).SetBytes(signature[:size])
+	s := new(big.Int).SetBytes(signature[size:])
+	// Assinaturas high-S são rejeitadas: apenas a forma canônica (low-S) é aceita
+	if s.Cmp(a.halfOrder()) > 0 {
+		return ErrVerificationFailed
+	}
+	if !ecdsa.Verify(pub, a.digest(data), r, s) {
+		return ErrVerificationFailed
+	}
+	return nil
+}
+
+// EncodePublicKey retorna o ponto público não comprimido (SEC 1).
+func (a ecdsaAlgorithm) EncodePublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	pub, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok || pub == nil || pub.Curve != a.curve {
+		return nil, ErrInvalidPublicKey
+	}
+	ecdhKey, err := pub.ECDH()
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return ecdhKey.Bytes(), nil
+}
+
+// encode converte uma assinatura ECDSA (r, s) para o formato de tamanho fixo r||s,
+// normalizando s para a forma low-S.
+func (a ecdsaAlgorithm) encode(r, s *big.Int) []byte {
+	if s.Cmp(a.halfOrder()) > 0 {
+		s = new(big.Int).Sub(a.curve.Params().N, s)
+	}
+	size := a.scalarSize()
+	out := make([]byte, 2*size)
+	r.FillBytes(out[:size])
+	s.FillBytes(out[size:])
+	return out
+}
+
+func (a ecdsaAlgorithm) halfOrder() *big.Int {
+	return new(big.Int).Rsh(a.curve.Params().N, 1)
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// TestECDSASignAndVerify testa o fluxo completo para P-256 e P-384, incluindo o tamanho fixo da assinatura.
+func TestECDSASignAndVerify(t *testing.T) {
+	testCases := []struct {
+		name    string
+		alg     Algorithm
+		curve   elliptic.Curve
+		sigSize int
+	}{
+		{"ES256", ES256, elliptic.P256(), 64},
+		{"ES384", ES384, elliptic.P384(), 96},
+	}
+	data := []byte("dados de teste signet-go")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priv, err := ecdsa.GenerateKey(tc.curve, rand.Reader)
+			if err != nil {
+				t.Fatalf("erro ao gerar chave: %v", err)
+			}
+			sig, err := tc.alg.Sign(priv, data)
+			if err != nil {
+				t.Fatalf("erro ao assinar: %v", err)
+			}
+			if len(sig) != tc.sigSize {
+				t.Errorf("esperava assinatura de %d bytes, obteve %d", tc.sigSize, len(sig))
+			}
+			if err := tc.alg.Verify(&priv.PublicKey, data, sig); err != nil {
+				t.Errorf("verificação falhou no happy path: %v", err)
+			}
+			corrupted := append([]byte(nil), data...)
+			corrupted[0] ^= 0xFF
+			if err := tc.alg.Verify(&priv.PublicKey, corrupted, sig); !errors.Is(err, ErrVerificationFailed) {
+				t.Errorf("esperado ErrVerificationFailed, obteve: %v", err)
+			}
+			if err := tc.alg.Verify(&priv.PublicKey, data, sig[:10]); !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("esperado ErrInvalidSignature, obteve: %v", err)
+			}
+		})
+	}
+}
+
+// TestECDSALowS garante que as assinaturas são emitidas em low-S e que a forma high-S equivalente é rejeitada.
+func TestECDSALowS(t *testing.T) {
+	priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	data := []byte("dados")
+	n := elliptic.P256().Params().N
+	half := new(big.Int).Rsh(n, 1)
+	for i := 0; i < 16; i++ {
+		sig, err := ES256.Sign(priv, data)
+		if err != nil {
+			t.Fatalf("erro ao assinar: %v", err)
+		}
+		s := new(big.Int).SetBytes(sig[32:])
+		if s.Cmp(half) > 0 {
+			t.Fatal("assinatura emitida não está normalizada em low-S")
+		}
+		highS := append([]byte(nil), sig...)
+		new(big.Int).Sub(n, s).FillBytes(highS[32:])
+		if err := ES256.Verify(&priv.PublicKey, data, highS); !errors.Is(err, ErrVerificationFailed) {
+			t.Fatalf("assinatura high-S deveria ser rejeitada, obteve: %v", err)
+		}
+	}
+}
+
+// TestECDSAKeyCurveMismatch garante que chaves de outra curva ou de outro tipo são rejeitadas.
+func TestECDSAKeyCurveMismatch(t *testing.T) {
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	data := []byte("dados")
+	if _, err := ES256.Sign(p384, data); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("esperado ErrInvalidPrivateKey, obteve: %v", err)
+	}
+	if err := ES256.Verify(&p384.PublicKey, data, make([]byte, 64)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+	if err := ES256.Verify([]byte("chave"), data, make([]byte, 64)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+	if _, err := ECDSAAlgorithmForCurve(elliptic.P521()); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("esperado ErrUnsupportedAlgorithm, obteve: %v", err)
+	}
+}
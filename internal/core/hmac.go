@@ -0,0 +1,68 @@
+package core
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+)
+
+// AlgHS256 identifica MACs HMAC-SHA256 (perfil simétrico).
+const AlgHS256 = "HS256"
+
+// MinHMACSecretSize é o tamanho mínimo, em bytes, de um segredo HMAC-SHA256:
+// o tamanho da saída do hash (RFC 7518, seção 3.2).
+const MinHMACSecretSize = sha256.Size
+
+// ErrWeakSecret indica que o segredo HMAC é menor que MinHMACSecretSize.
+var ErrWeakSecret = errors.New("segredo HMAC com tamanho inferior ao mínimo exigido")
+
+// HS256 é a implementação de Algorithm para HMAC-SHA256. Tanto a "chave privada"
+// quanto a "chave pública" são o segredo compartilhado, como []byte.
+var HS256 Algorithm = hmacAlgorithm{}
+
+type hmacAlgorithm struct{}
+
+func (hmacAlgorithm) ID() string { return AlgHS256 }
+
+func (hmacAlgorithm) Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	secret, err := hmacSecret(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrNilData
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return mac.Sum(nil), nil
+}
+
+// Verify recalcula o MAC e o compara em tempo constante.
+func (a hmacAlgorithm) Verify(publicKey crypto.PublicKey, data, signature []byte) error {
+	if _, ok := publicKey.([]byte); !ok {
+		return ErrInvalidPublicKey
+	}
+	if len(signature) != sha256.Size {
+		return ErrInvalidSignature
+	}
+	expected, err := a.Sign(publicKey, data)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(expected, signature) {
+		return ErrVerificationFailed
+	}
+	return nil
+}
+
+func hmacSecret(key any) ([]byte, error) {
+	secret, ok := key.([]byte)
+	if !ok {
+		return nil, ErrInvalidPrivateKey
+	}
+	if len(secret) < MinHMACSecretSize {
+		return nil, ErrWeakSecret
+	}
+	return secret, nil
+}
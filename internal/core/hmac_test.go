@@ -0,0 +1,42 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestHS256SignAndVerify testa o fluxo completo e a detecção de adulteração.
+func TestHS256SignAndVerify(t *testing.T) {
+	secret := bytes.Repeat([]byte{0x42}, MinHMACSecretSize)
+	data := []byte("dados de teste signet-go")
+	mac, err := HS256.Sign(secret, data)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	if err := HS256.Verify(secret, data, mac); err != nil {
+		t.Errorf("verificação falhou no happy path: %v", err)
+	}
+	mac[0] ^= 0xFF
+	if err := HS256.Verify(secret, data, mac); !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf("esperado ErrVerificationFailed, obteve: %v", err)
+	}
+}
+
+// TestHS256InputErrors cobre segredos curtos, tipos de chave incorretos e MACs com tamanho errado.
+func TestHS256InputErrors(t *testing.T) {
+	data := []byte("dados")
+	short := make([]byte, MinHMACSecretSize-1)
+	if _, err := HS256.Sign(short, data); !errors.Is(err, ErrWeakSecret) {
+		t.Errorf("esperado ErrWeakSecret, obteve: %v", err)
+	}
+	if err := HS256.Verify(short, data, make([]byte, 32)); !errors.Is(err, ErrWeakSecret) {
+		t.Errorf("esperado ErrWeakSecret, obteve: %v", err)
+	}
+	if err := HS256.Verify("segredo", data, make([]byte, 32)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+	if err := HS256.Verify(make([]byte, MinHMACSecretSize), data, make([]byte, 16)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("esperado ErrInvalidSignature, obteve: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"crypto"
+	"crypto/rand"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+)
+
+// AlgMLDSA65 identifica assinaturas pós-quânticas ML-DSA-65 (FIPS 204, nível de segurança 3).
+const AlgMLDSA65 = "ML-DSA-65"
+
+// Tamanhos, em bytes, das chaves e assinaturas ML-DSA-65.
+// São ordens de grandeza maiores que os equivalentes Ed25519 (32 e 64 bytes).
+const (
+	MLDSA65PublicKeySize = mldsa65.PublicKeySize
+	MLDSA65SignatureSize = mldsa65.SignatureSize
+)
+
+// MLDSA65 é a implementação de Algorithm para ML-DSA-65, com contexto vazio e assinatura hedged (randomizada).
+var MLDSA65 Algorithm = mldsaAlgorithm{}
+
+type mldsaAlgorithm struct{}
+
+func (mldsaAlgorithm) ID() string { return AlgMLDSA65 }
+
+func (mldsaAlgorithm) Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	priv, ok := privateKey.(*mldsa65.PrivateKey)
+	if !ok || priv == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	if data == nil {
+		return nil, ErrNilData
+	}
+	sig := make([]byte, mldsa65.SignatureSize)
+	if err := mldsa65.SignTo(priv, data, nil, true, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// Verify aceita a chave pública como *mldsa65.PublicKey ou em sua codificação
+// compacta de MLDSA65PublicKeySize bytes, como normalmente armazenada por resolvers.
+func (mldsaAlgorithm) Verify(publicKey crypto.PublicKey, data, signature []byte) error {
+	pub, err := ParseMLDSA65PublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return ErrNilData
+	}
+	if len(signature) != mldsa65.SignatureSize {
+		return ErrInvalidSignature
+	}
+	if !mldsa65.Verify(pub, data, nil, signature) {
+		return ErrVerificationFailed
+	}
+	return nil
+}
+
+// EncodePublicKey retorna a codificação compacta da chave, fornecida em qualquer das
+// representações aceitas por Verify.
+func (mldsaAlgorithm) EncodePublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	pub, err := ParseMLDSA65PublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pub.Bytes(), nil
+}
+
+// SignWithSigner implementa SignerAlgorithm. ML-DSA assina a mensagem completa (sem hash prévio).
+func (mldsaAlgorithm) SignWithSigner(sign SignFunc, data []byte) ([]byte, error) {
+	if data == nil {
+		return nil, ErrNilData
+	}
+	sig, err := sign(rand.Reader, data, crypto.Hash(0))
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != mldsa65.SignatureSize {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+// ParseMLDSA65PublicKey converte uma chave pública ML-DSA-65, fornecida como
+// *mldsa65.PublicKey ou como sua codificação em bytes, validando o tamanho.
+func ParseMLDSA65PublicKey(publicKey crypto.PublicKey) (*mldsa65.PublicKey, error) {
+	switch pub := publicKey.(type) {
+	case *mldsa65.PublicKey:
+		if pub == nil {
+			return nil, ErrInvalidPublicKey
+		}
+		return pub, nil
+	case []byte:
+		if len(pub) != mldsa65.PublicKeySize {
+			return nil, ErrInvalidPublicKey
+		}
+		var parsed mldsa65.PublicKey
+		if err := parsed.UnmarshalBinary(pub); err != nil {
+			return nil, ErrInvalidPublicKey
+		}
+		return &parsed, nil
+	}
+	return nil, ErrInvalidPublicKey
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+)
+
+// TestMLDSA65SignAndVerify testa o fluxo completo com a chave como objeto e como bytes codificados.
+func TestMLDSA65SignAndVerify(t *testing.T) {
+	pub, priv, err := mldsa65.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("erro ao gerar chave: %v", err)
+	}
+	data := []byte("dados de teste signet-go")
+	sig, err := MLDSA65.Sign(priv, data)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	if len(sig) != MLDSA65SignatureSize {
+		t.Errorf("esperava assinatura de %d bytes, obteve %d", MLDSA65SignatureSize, len(sig))
+	}
+	if err := MLDSA65.Verify(pub, data, sig); err != nil {
+		t.Errorf("verificação falhou com *mldsa65.PublicKey: %v", err)
+	}
+	if err := MLDSA65.Verify(pub.Bytes(), data, sig); err != nil {
+		t.Errorf("verificação falhou com chave codificada: %v", err)
+	}
+	corrupted := append([]byte(nil), sig...)
+	corrupted[0] ^= 0xFF
+	if err := MLDSA65.Verify(pub, data, corrupted); !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf("esperado ErrVerificationFailed, obteve: %v", err)
+	}
+}
+
+// TestMLDSA65InputErrors cobre chaves e assinaturas com tamanho ou tipo incorretos.
+func TestMLDSA65InputErrors(t *testing.T) {
+	pub, _, _ := mldsa65.GenerateKey(nil)
+	data := []byte("dados")
+	if err := MLDSA65.Verify(pub.Bytes()[:100], data, make([]byte, MLDSA65SignatureSize)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+	if err := MLDSA65.Verify(pub, data, make([]byte, 64)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("esperado ErrInvalidSignature, obteve: %v", err)
+	}
+	if _, err := MLDSA65.Sign("não é uma chave", data); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("esperado ErrInvalidPrivateKey, obteve: %v", err)
+	}
+}
+
+// BenchmarkMLDSA65Verify mede o custo da verificação ML-DSA-65 para comparação com BenchmarkVerify.
+func BenchmarkMLDSA65Verify(b *testing.B) {
+	pub, priv, _ := mldsa65.GenerateKey(nil)
+	payload := make([]byte, 256)
+	sig, err := MLDSA65.Sign(priv, payload)
+	if err != nil {
+		b.Fatalf("erro ao assinar: %v", err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := MLDSA65.Verify(pub, payload, sig); err != nil {
+			b.Fatalf("verificação falhou: %v", err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// EncX25519A256GCM identifica o esquema de cifragem de tokens selados: acordo de chaves
+// X25519 com chave efêmera, derivação HKDF-SHA256 e AES-256-GCM.
+const EncX25519A256GCM = "X25519-HKDF-SHA256-A256GCM"
+
+// sealInfo separa as chaves derivadas para tokens selados de qualquer outro uso do segredo X25519.
+const sealInfo = "signet-seal-v1"
+
+// ErrDecryptionFailed indica que o token selado não pôde ser decifrado: chave do destinatário
+// incorreta, dados associados divergentes ou texto cifrado adulterado.
+var ErrDecryptionFailed = errors.New("falha ao decifrar o token selado")
+
+// SealAAD retorna os dados associados autenticados de um token selado: o esquema e o kid do
+// destinatário, cada um prefixado pelo seu tamanho para que a codificação seja injetiva.
+func SealAAD(enc, recipientKid string) []byte {
+	aad := make([]byte, 0, len(sealInfo)+len(enc)+len(recipientKid)+2*binary.MaxVarintLen64)
+	aad = append(aad, sealInfo...)
+	aad = binary.AppendUvarint(aad, uint64(len(enc)))
+	aad = append(aad, enc...)
+	aad = binary.AppendUvarint(aad, uint64(len(recipientKid)))
+	aad = append(aad, recipientKid...)
+	return aad
+}
+
+// Seal cifra plaintext para a chave pública X25519 do destinatário com EncX25519A256GCM.
+// Cada chamada gera um par de chaves efêmero, de modo que chave e nonce do AES-GCM, ambos
+// derivados via HKDF do segredo compartilhado, nunca se repetem.
+// Retorna a chave pública efêmera e o texto cifrado (com a tag de autenticação).
+func Seal(recipient *ecdh.PublicKey, aad, plaintext []byte) (ephemeralPublicKey, ciphertext []byte, err error) {
+	if recipient == nil || recipient.Curve() != ecdh.X25519() {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	if plaintext == nil {
+		return nil, nil, ErrNilData
+	}
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	ephemeralPublicKey = ephemeral.PublicKey().Bytes()
+	aead, nonce, err := sealAEAD(shared, ephemeralPublicKey, recipient.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	return ephemeralPublicKey, aead.Seal(nil, nonce, plaintext, aad), nil
+}
+
+// Open decifra um texto cifrado produzido por Seal com a chave privada X25519 do destinatário.
+// Qualquer falha de autenticação retorna ErrDecryptionFailed, sem distinguir a causa.
+func Open(recipient *ecdh.PrivateKey, ephemeralPublicKey, aad, ciphertext []byte) ([]byte, error) {
+	if recipient == nil || recipient.Curve() != ecdh.X25519() {
+		return nil, ErrInvalidPrivateKey
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(ephemeralPublicKey)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	shared, err := recipient.ECDH(ephemeral)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	aead, nonce, err := sealAEAD(shared, ephemeralPublicKey, recipient.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+// sealAEAD deriva a chave e o nonce do AES-256-GCM a partir do segredo compartilhado,
+// vinculando-os às chaves públicas efêmera e do destinatário.
+func sealAEAD(shared, ephemeralPublicKey, recipientPublicKey []byte) (cipher.AEAD, []byte, error) {
+	salt := make([]byte, 0, len(ephemeralPublicKey)+len(recipientPublicKey))
+	salt = append(salt, ephemeralPublicKey...)
+	salt = append(salt, recipientPublicKey...)
+	okm, err := hkdf.Key(sha256.New, shared, salt, sealInfo, 32+12)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, err := aes.NewCipher(okm[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, okm[32:], nil
+}
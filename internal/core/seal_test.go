@@ -0,0 +1,79 @@
+package core
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+// TestSealAndOpen testa o fluxo completo e a detecção de adulteração.
+func TestSealAndOpen(t *testing.T) {
+	recipient, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	other, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	aad := SealAAD(EncX25519A256GCM, "destinatario-v1")
+	plaintext := []byte("dados confidenciais signet-go")
+
+	ephemeral, ciphertext, err := Seal(recipient.PublicKey(), aad, plaintext)
+	if err != nil {
+		t.Fatalf("erro ao selar: %v", err)
+	}
+	if bytes.Contains(ciphertext, plaintext) {
+		t.Fatal("texto cifrado contém o texto claro")
+	}
+	opened, err := Open(recipient, ephemeral, aad, ciphertext)
+	if err != nil {
+		t.Fatalf("erro ao abrir no happy path: %v", err)
+	}
+	if !bytes.Equal(opened, plaintext) {
+		t.Errorf("texto decifrado incorreto: %q", opened)
+	}
+
+	// Selagens sucessivas usam chaves efêmeras distintas
+	ephemeral2, ciphertext2, _ := Seal(recipient.PublicKey(), aad, plaintext)
+	if bytes.Equal(ephemeral, ephemeral2) || bytes.Equal(ciphertext, ciphertext2) {
+		t.Error("selagens sucessivas não deveriam se repetir")
+	}
+
+	tampered := append([]byte(nil), ciphertext...)
+	tampered[0] ^= 0xFF
+	testCases := []struct {
+		name       string
+		key        *ecdh.PrivateKey
+		ephemeral  []byte
+		aad        []byte
+		ciphertext []byte
+	}{
+		{"Falha: chave do destinatário incorreta", other, ephemeral, aad, ciphertext},
+		{"Falha: kid divergente nos dados associados", recipient, ephemeral, SealAAD(EncX25519A256GCM, "destinatario-v2"), ciphertext},
+		{"Falha: chave efêmera trocada", recipient, ephemeral2, aad, ciphertext},
+		{"Falha: chave efêmera malformada", recipient, ephemeral[:16], aad, ciphertext},
+		{"Falha: texto cifrado adulterado", recipient, ephemeral, aad, tampered},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Open(tc.key, tc.ephemeral, tc.aad, tc.ciphertext); !errors.Is(err, ErrDecryptionFailed) {
+				t.Errorf("esperado ErrDecryptionFailed, obteve: %v", err)
+			}
+		})
+	}
+}
+
+// TestSealInputErrors cobre chaves de curva incorreta e dados nulos.
+func TestSealInputErrors(t *testing.T) {
+	p256, _ := ecdh.P256().GenerateKey(rand.Reader)
+	recipient, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	if _, _, err := Seal(p256.PublicKey(), nil, []byte("dados")); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+	if _, _, err := Seal(nil, nil, []byte("dados")); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperado ErrInvalidPublicKey, obteve: %v", err)
+	}
+	if _, _, err := Seal(recipient.PublicKey(), nil, nil); !errors.Is(err, ErrNilData) {
+		t.Errorf("esperado ErrNilData, obteve: %v", err)
+	}
+	if _, err := Open(p256, make([]byte, 32), nil, nil); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("esperado ErrInvalidPrivateKey, obteve: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/asn1"
+	"io"
+	"math/big"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+)
+
+// SignFunc tem a mesma assinatura de crypto.Signer.Sign e permite delegar a geração
+// da assinatura a um assinador externo (KMS, HSM, etc.).
+type SignFunc func(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error)
+
+// SignerAlgorithm é implementado pelos algoritmos capazes de assinar através de um
+// crypto.Signer, sem acesso ao material da chave privada.
+type SignerAlgorithm interface {
+	Algorithm
+	// SignWithSigner prepara os dados no formato esperado pelo assinador, delega a
+	// assinatura a sign e converte o resultado para a codificação do algoritmo.
+	SignWithSigner(sign SignFunc, data []byte) ([]byte, error)
+}
+
+// AlgorithmForPublicKey retorna o identificador do algoritmo correspondente ao tipo da chave pública.
+func AlgorithmForPublicKey(publicKey crypto.PublicKey) (string, error) {
+	switch pub := publicKey.(type) {
+	case ed25519.PublicKey:
+		return AlgEd25519, nil
+	case *ecdsa.PublicKey:
+		if pub == nil {
+			return "", ErrInvalidPublicKey
+		}
+		return ECDSAAlgorithmForCurve(pub.Curve)
+	case *mldsa65.PublicKey:
+		return AlgMLDSA65, nil
+	}
+	return "", ErrUnsupportedAlgorithm
+}
+
+// SignWithSigner implementa SignerAlgorithm. Ed25519 assina a mensagem completa (sem hash prévio).
+func (ed25519Algorithm) SignWithSigner(sign SignFunc, data []byte) ([]byte, error) {
+	if data == nil {
+		return nil, ErrNilData
+	}
+	sig, err := sign(rand.Reader, data, crypto.Hash(0))
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != ed25519.SignatureSize {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+// SignWithSigner implementa SignerAlgorithm. O assinador recebe o digest e retorna a
+// assinatura em ASN.1 DER, que é convertida para r||s com normalização low-S.
+func (a ecdsaAlgorithm) SignWithSigner(sign SignFunc, data []byte) ([]byte, error) {
+	if data == nil {
+		return nil, ErrNilData
+	}
+	der, err := sign(rand.Reader, a.digest(data), a.hash)
+	if err != nil {
+		return nil, err
+	}
+	var parsed struct{ R, S *big.Int }
+	rest, err := asn1.Unmarshal(der, &parsed)
+	if err != nil || len(rest) != 0 || parsed.R == nil || parsed.S == nil {
+		return nil, ErrInvalidSignature
+	}
+	if parsed.R.Sign() <= 0 || parsed.S.Sign() <= 0 || parsed.R.BitLen() > a.scalarSize()*8 || parsed.S.BitLen() > a.scalarSize()*8 {
+		return nil, ErrInvalidSignature
+	}
+	return a.encode(parsed.R, parsed.S), nil
+}
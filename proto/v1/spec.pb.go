@@ -9,6 +9,7 @@ package signetv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -40,6 +41,8 @@ type SignetPayload struct {
 	// (aud) Audience: O(s) destinatário(s) para o(s) qual(is) o token se destina.
 	// O validador DEVE verificar se ele se identifica como parte desta audiência.
 	// Se a audiência não corresponder, o token DEVE ser rejeitado.
+	// Em tokens com várias audiências, contém a primeira delas e as demais ficam
+	// em 'additional_audiences', preservando a semântica para validadores antigos.
 	Aud string `protobuf:"bytes,4,opt,name=aud,proto3" json:"aud,omitempty"`
 	// (sid) Session ID: Um identificador único para o token, usado para
 	// possibilitar a revogação. REQUERIDO para o perfil STATEFUL.
@@ -54,7 +57,33 @@ type SignetPayload struct {
 	Roles []string `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
 	// (kid) Key ID: Um identificador opcional para a chave usada para assinar o token.
 	// Ajuda o validador a selecionar a chave pública correta para verificação.
-	Kid           string `protobuf:"bytes,8,opt,name=kid,proto3" json:"kid,omitempty"`
+	Kid string `protobuf:"bytes,8,opt,name=kid,proto3" json:"kid,omitempty"`
+	// (nbf) Not Before: O tempo, em segundos no formato Unix Timestamp,
+	// antes do qual o token NÃO DEVE ser aceito para processamento.
+	// Permite emitir tokens antecipadamente, válidos a partir de um horário
+	// agendado. Se presente, DEVE satisfazer iat <= nbf < exp.
+	Nbf int64 `protobuf:"varint,9,opt,name=nbf,proto3" json:"nbf,omitempty"`
+	// (iss) Issuer: O identificador do emissor que gerou o token.
+	// Permite que validadores aceitem apenas emissores conhecidos e que o 'kid'
+	// seja único apenas dentro de cada emissor.
+	Iss string `protobuf:"bytes,10,opt,name=iss,proto3" json:"iss,omitempty"`
+	// (jti) Token ID: Um identificador único para este token específico,
+	// distinto do 'sid', que identifica a sessão. Permite rejeitar a reutilização
+	// (replay) de um token capturado. Gerado automaticamente pelo emissor com
+	// 16 bytes aleatórios.
+	Jti []byte `protobuf:"bytes,11,opt,name=jti,proto3" json:"jti,omitempty"`
+	// Audiências adicionais, além de 'aud'. O token é aceito por um validador que
+	// se identifique com 'aud' ou com qualquer um destes valores. Validadores
+	// anteriores a este campo o ignoram e aceitam apenas 'aud'.
+	AdditionalAudiences []string `protobuf:"bytes,12,rep,name=additional_audiences,json=additionalAudiences,proto3" json:"additional_audiences,omitempty"`
+	// Claims customizados tipados, para valores que não são strings (números,
+	// booleanos, listas) e dispensam conversão a cada requisição. Complementa
+	// 'custom_claims': uma mesma chave não deve aparecer nos dois mapas.
+	TypedClaims map[string]*ClaimValue `protobuf:"bytes,13,rep,name=typed_claims,json=typedClaims,proto3" json:"typed_claims,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Extensão com os claims de domínio da aplicação, em uma mensagem protobuf
+	// própria. O type URL identifica a mensagem e é verificado pelo validador
+	// antes da deserialização.
+	Extension     *anypb.Any `protobuf:"bytes,14,opt,name=extension,proto3" json:"extension,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -145,6 +174,208 @@ func (x *SignetPayload) GetKid() string {
 	return ""
 }
 
+func (x *SignetPayload) GetNbf() int64 {
+	if x != nil {
+		return x.Nbf
+	}
+	return 0
+}
+
+func (x *SignetPayload) GetIss() string {
+	if x != nil {
+		return x.Iss
+	}
+	return ""
+}
+
+func (x *SignetPayload) GetJti() []byte {
+	if x != nil {
+		return x.Jti
+	}
+	return nil
+}
+
+func (x *SignetPayload) GetAdditionalAudiences() []string {
+	if x != nil {
+		return x.AdditionalAudiences
+	}
+	return nil
+}
+
+func (x *SignetPayload) GetTypedClaims() map[string]*ClaimValue {
+	if x != nil {
+		return x.TypedClaims
+	}
+	return nil
+}
+
+func (x *SignetPayload) GetExtension() *anypb.Any {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// ClaimValue é o valor tipado de um claim customizado.
+type ClaimValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*ClaimValue_StringValue
+	//	*ClaimValue_IntValue
+	//	*ClaimValue_BoolValue
+	//	*ClaimValue_StringsValue
+	Kind          isClaimValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimValue) Reset() {
+	*x = ClaimValue{}
+	mi := &file_proto_v1_spec_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimValue) ProtoMessage() {}
+
+func (x *ClaimValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_spec_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimValue.ProtoReflect.Descriptor instead.
+func (*ClaimValue) Descriptor() ([]byte, []int) {
+	return file_proto_v1_spec_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClaimValue) GetKind() isClaimValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *ClaimValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*ClaimValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *ClaimValue) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Kind.(*ClaimValue_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *ClaimValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*ClaimValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *ClaimValue) GetStringsValue() *StringList {
+	if x != nil {
+		if x, ok := x.Kind.(*ClaimValue_StringsValue); ok {
+			return x.StringsValue
+		}
+	}
+	return nil
+}
+
+type isClaimValue_Kind interface {
+	isClaimValue_Kind()
+}
+
+type ClaimValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type ClaimValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type ClaimValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type ClaimValue_StringsValue struct {
+	StringsValue *StringList `protobuf:"bytes,4,opt,name=strings_value,json=stringsValue,proto3,oneof"`
+}
+
+func (*ClaimValue_StringValue) isClaimValue_Kind() {}
+
+func (*ClaimValue_IntValue) isClaimValue_Kind() {}
+
+func (*ClaimValue_BoolValue) isClaimValue_Kind() {}
+
+func (*ClaimValue_StringsValue) isClaimValue_Kind() {}
+
+// StringList é uma lista de strings usada como valor de claim.
+type StringList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_proto_v1_spec_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_spec_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_proto_v1_spec_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 // SignetToken é a estrutura final que é serializada para bytes e transportada.
 // Ela encapsula o payload e a assinatura, garantindo a integridade dos dados.
 type SignetToken struct {
@@ -153,15 +384,44 @@ type SignetToken struct {
 	// Este campo contém a informação de identidade real.
 	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	// A assinatura digital dos bytes do campo 'payload'.
-	// O algoritmo utilizado é definido pela especificação (Ed25519 para a v1.0).
-	Signature     []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// O algoritmo utilizado é identificado pelo campo 'alg'. Se o emissor definir
+	// um contexto de assinatura, a mensagem assinada é
+	// "signet-ctx-v1\x00" || len(contexto) || contexto || payload, e o validador
+	// DEVE ser configurado com o mesmo contexto.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// (alg) Algorithm: O identificador do algoritmo usado para produzir 'signature'
+	// (ex: "Ed25519"). O validador DEVE despachar a verificação para o algoritmo
+	// declarado e DEVE rejeitar o token se a chave resolvida não tiver sido
+	// registrada para esse mesmo algoritmo. Tokens sem este campo são tratados
+	// como Ed25519, o algoritmo padrão da especificação v1.0; no modo de
+	// decodificação estrita, o campo é obrigatório.
+	Alg string `protobuf:"bytes,3,opt,name=alg,proto3" json:"alg,omitempty"`
+	// Assinaturas adicionais sobre os mesmos bytes do campo 'payload'.
+	// Usadas por tokens híbridos, que carregam uma assinatura clássica em
+	// 'signature' e uma assinatura pós-quântica (ex: ML-DSA-65) aqui, e por
+	// tokens co-assinados por vários emissores independentes, cada um com seu
+	// próprio 'kid'. As políticas de verificação (híbrida e de limiar k-de-n)
+	// são definidas pelo validador. Este campo não é coberto pela assinatura
+	// principal: no modo de decodificação estrita, toda assinatura adicional
+	// DEVE ser válida e de um par (kid, alg) distinto, mesmo que a política
+	// ativa não a exija.
+	Signatures []*SignetSignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// Versão do formato do token. O validador DEVE despachar a validação para a
+	// versão declarada e DEVE rejeitar versões que não suporta. Tokens sem este
+	// campo são tokens legados (v1.0) e são tratados como versão 1. Quando
+	// presente, a versão é vinculada à mensagem assinada pelo prefixo
+	// "signet-msg\0" || versão (uint32 big-endian) || len(contexto) || contexto
+	// || payload; tokens legados assinam o payload (ou o payload com o contexto
+	// de assinatura) sem a versão. Alterar ou remover a versão invalida a
+	// assinatura. No modo de decodificação estrita, o campo é obrigatório.
+	Version       uint32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignetToken) Reset() {
 	*x = SignetToken{}
-	mi := &file_proto_v1_spec_proto_msgTypes[1]
+	mi := &file_proto_v1_spec_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +433,7 @@ func (x *SignetToken) String() string {
 func (*SignetToken) ProtoMessage() {}
 
 func (x *SignetToken) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_spec_proto_msgTypes[1]
+	mi := &file_proto_v1_spec_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +446,7 @@ func (x *SignetToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignetToken.ProtoReflect.Descriptor instead.
 func (*SignetToken) Descriptor() ([]byte, []int) {
-	return file_proto_v1_spec_proto_rawDescGZIP(), []int{1}
+	return file_proto_v1_spec_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SignetToken) GetPayload() []byte {
@@ -203,11 +463,179 @@ func (x *SignetToken) GetSignature() []byte {
 	return nil
 }
 
+func (x *SignetToken) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *SignetToken) GetSignatures() []*SignetSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *SignetToken) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SignetSignature é uma assinatura adicional transportada pelo SignetToken.
+type SignetSignature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// O identificador do algoritmo usado para produzir 'signature'. Obrigatório
+	// no modo de decodificação estrita.
+	Alg string `protobuf:"bytes,1,opt,name=alg,proto3" json:"alg,omitempty"`
+	// A assinatura digital dos bytes do campo 'payload' do SignetToken.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// (kid) Key ID: O identificador da chave que produziu esta assinatura.
+	// Se ausente, é o 'kid' do payload (mesmo emissor da assinatura principal);
+	// no modo de decodificação estrita, o 'kid' do payload não pode ser repetido aqui.
+	Kid           string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignetSignature) Reset() {
+	*x = SignetSignature{}
+	mi := &file_proto_v1_spec_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignetSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignetSignature) ProtoMessage() {}
+
+func (x *SignetSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_spec_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignetSignature.ProtoReflect.Descriptor instead.
+func (*SignetSignature) Descriptor() ([]byte, []int) {
+	return file_proto_v1_spec_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignetSignature) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *SignetSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SignetSignature) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+// SignetSealedToken é um SignetToken assinado e depois cifrado para um único
+// destinatário (sign-then-encrypt), ocultando os claims de quem apenas
+// transporta o token. O texto claro é o SignetToken serializado, validado
+// normalmente após a abertura.
+type SignetSealedToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// O identificador do esquema de cifragem. Atualmente apenas
+	// "X25519-HKDF-SHA256-A256GCM": acordo de chaves X25519 com chave efêmera,
+	// derivação HKDF-SHA256 da chave e do nonce e cifragem AES-256-GCM.
+	Enc string `protobuf:"bytes,1,opt,name=enc,proto3" json:"enc,omitempty"`
+	// O identificador da chave X25519 do destinatário, usado para selecionar a
+	// chave privada durante a rotação. Autenticado junto com 'enc' como dados
+	// associados da cifragem.
+	RecipientKid string `protobuf:"bytes,2,opt,name=recipient_kid,json=recipientKid,proto3" json:"recipient_kid,omitempty"`
+	// A chave pública X25519 efêmera gerada pelo emissor para este token.
+	EphemeralPublicKey []byte `protobuf:"bytes,3,opt,name=ephemeral_public_key,json=ephemeralPublicKey,proto3" json:"ephemeral_public_key,omitempty"`
+	// O SignetToken serializado, cifrado e seguido da tag de autenticação.
+	Ciphertext    []byte `protobuf:"bytes,4,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignetSealedToken) Reset() {
+	*x = SignetSealedToken{}
+	mi := &file_proto_v1_spec_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignetSealedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignetSealedToken) ProtoMessage() {}
+
+func (x *SignetSealedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_spec_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignetSealedToken.ProtoReflect.Descriptor instead.
+func (*SignetSealedToken) Descriptor() ([]byte, []int) {
+	return file_proto_v1_spec_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SignetSealedToken) GetEnc() string {
+	if x != nil {
+		return x.Enc
+	}
+	return ""
+}
+
+func (x *SignetSealedToken) GetRecipientKid() string {
+	if x != nil {
+		return x.RecipientKid
+	}
+	return ""
+}
+
+func (x *SignetSealedToken) GetEphemeralPublicKey() []byte {
+	if x != nil {
+		return x.EphemeralPublicKey
+	}
+	return nil
+}
+
+func (x *SignetSealedToken) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
 var File_proto_v1_spec_proto protoreflect.FileDescriptor
 
 const file_proto_v1_spec_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/v1/spec.proto\x12\tsignet.v1\"\xa3\x02\n" +
+	"\x13proto/v1/spec.proto\x12\tsignet.v1\x1a\x19google/protobuf/any.proto\"\xe5\x04\n" +
 	"\rSignetPayload\x12\x10\n" +
 	"\x03exp\x18\x01 \x01(\x03R\x03exp\x12\x10\n" +
 	"\x03iat\x18\x02 \x01(\x03R\x03iat\x12\x10\n" +
@@ -216,13 +644,50 @@ const file_proto_v1_spec_proto_rawDesc = "" +
 	"\x03sid\x18\x05 \x01(\fR\x03sid\x12O\n" +
 	"\rcustom_claims\x18\x06 \x03(\v2*.signet.v1.SignetPayload.CustomClaimsEntryR\fcustomClaims\x12\x14\n" +
 	"\x05roles\x18\a \x03(\tR\x05roles\x12\x10\n" +
-	"\x03kid\x18\b \x01(\tR\x03kid\x1a?\n" +
+	"\x03kid\x18\b \x01(\tR\x03kid\x12\x10\n" +
+	"\x03nbf\x18\t \x01(\x03R\x03nbf\x12\x10\n" +
+	"\x03iss\x18\n" +
+	" \x01(\tR\x03iss\x12\x10\n" +
+	"\x03jti\x18\v \x01(\fR\x03jti\x121\n" +
+	"\x14additional_audiences\x18\f \x03(\tR\x13additionalAudiences\x12L\n" +
+	"\ftyped_claims\x18\r \x03(\v2).signet.v1.SignetPayload.TypedClaimsEntryR\vtypedClaims\x122\n" +
+	"\textension\x18\x0e \x01(\v2\x14.google.protobuf.AnyR\textension\x1a?\n" +
 	"\x11CustomClaimsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x10TypedClaimsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.signet.v1.ClaimValueR\x05value:\x028\x01\"\xb7\x01\n" +
+	"\n" +
+	"ClaimValue\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x03H\x00R\bintValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x03 \x01(\bH\x00R\tboolValue\x12<\n" +
+	"\rstrings_value\x18\x04 \x01(\v2\x15.signet.v1.StringListH\x00R\fstringsValueB\x06\n" +
+	"\x04kind\"$\n" +
+	"\n" +
+	"StringList\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xad\x01\n" +
 	"\vSignetToken\x12\x18\n" +
 	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1c\n" +
-	"\tsignature\x18\x02 \x01(\fR\tsignatureB\x95\x01\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\x12\x10\n" +
+	"\x03alg\x18\x03 \x01(\tR\x03alg\x12:\n" +
+	"\n" +
+	"signatures\x18\x04 \x03(\v2\x1a.signet.v1.SignetSignatureR\n" +
+	"signatures\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\rR\aversion\"S\n" +
+	"\x0fSignetSignature\x12\x10\n" +
+	"\x03alg\x18\x01 \x01(\tR\x03alg\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\x12\x10\n" +
+	"\x03kid\x18\x03 \x01(\tR\x03kid\"\x9c\x01\n" +
+	"\x11SignetSealedToken\x12\x10\n" +
+	"\x03enc\x18\x01 \x01(\tR\x03enc\x12#\n" +
+	"\rrecipient_kid\x18\x02 \x01(\tR\frecipientKid\x120\n" +
+	"\x14ephemeral_public_key\x18\x03 \x01(\fR\x12ephemeralPublicKey\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x04 \x01(\fR\n" +
+	"ciphertextB\x95\x01\n" +
 	"\rcom.signet.v1B\tSpecProtoP\x01Z4github.com/lucas-de-lima/signet-go/proto/v1;signetv1\xa2\x02\x03SXX\xaa\x02\tSignet.V1\xca\x02\tSignet\\V1\xe2\x02\x15Signet\\V1\\GPBMetadata\xea\x02\n" +
 	"Signet::V1b\x06proto3"
 
@@ -238,19 +703,30 @@ func file_proto_v1_spec_proto_rawDescGZIP() []byte {
 	return file_proto_v1_spec_proto_rawDescData
 }
 
-var file_proto_v1_spec_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_v1_spec_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_v1_spec_proto_goTypes = []any{
-	(*SignetPayload)(nil), // 0: signet.v1.SignetPayload
-	(*SignetToken)(nil),   // 1: signet.v1.SignetToken
-	nil,                   // 2: signet.v1.SignetPayload.CustomClaimsEntry
+	(*SignetPayload)(nil),     // 0: signet.v1.SignetPayload
+	(*ClaimValue)(nil),        // 1: signet.v1.ClaimValue
+	(*StringList)(nil),        // 2: signet.v1.StringList
+	(*SignetToken)(nil),       // 3: signet.v1.SignetToken
+	(*SignetSignature)(nil),   // 4: signet.v1.SignetSignature
+	(*SignetSealedToken)(nil), // 5: signet.v1.SignetSealedToken
+	nil,                       // 6: signet.v1.SignetPayload.CustomClaimsEntry
+	nil,                       // 7: signet.v1.SignetPayload.TypedClaimsEntry
+	(*anypb.Any)(nil),         // 8: google.protobuf.Any
 }
 var file_proto_v1_spec_proto_depIdxs = []int32{
-	2, // 0: signet.v1.SignetPayload.custom_claims:type_name -> signet.v1.SignetPayload.CustomClaimsEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: signet.v1.SignetPayload.custom_claims:type_name -> signet.v1.SignetPayload.CustomClaimsEntry
+	7, // 1: signet.v1.SignetPayload.typed_claims:type_name -> signet.v1.SignetPayload.TypedClaimsEntry
+	8, // 2: signet.v1.SignetPayload.extension:type_name -> google.protobuf.Any
+	2, // 3: signet.v1.ClaimValue.strings_value:type_name -> signet.v1.StringList
+	4, // 4: signet.v1.SignetToken.signatures:type_name -> signet.v1.SignetSignature
+	1, // 5: signet.v1.SignetPayload.TypedClaimsEntry.value:type_name -> signet.v1.ClaimValue
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_spec_proto_init() }
@@ -258,13 +734,19 @@ func file_proto_v1_spec_proto_init() {
 	if File_proto_v1_spec_proto != nil {
 		return
 	}
+	file_proto_v1_spec_proto_msgTypes[1].OneofWrappers = []any{
+		(*ClaimValue_StringValue)(nil),
+		(*ClaimValue_IntValue)(nil),
+		(*ClaimValue_BoolValue)(nil),
+		(*ClaimValue_StringsValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_spec_proto_rawDesc), len(file_proto_v1_spec_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
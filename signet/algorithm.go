@@ -0,0 +1,107 @@
+package signet
+
+import (
+	"context"
+	"crypto"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+)
+
+// Algorithm define o contrato de um algoritmo de assinatura plugável.
+// Novos algoritmos podem ser adicionados via RegisterAlgorithm sem alterar a biblioteca.
+// Verify deve retornar ErrVerificationFailed (ou um erro que o envolva) quando a assinatura
+// não confere, para que o Parse a reporte como ErrInvalidSignature. Chaves de tipo inesperado
+// devem ser rejeitadas com ErrInvalidPublicKey ou ErrInvalidPrivateKey: esses e quaisquer
+// outros erros também rejeitam o token, mas são propagados sem conversão.
+type Algorithm = core.Algorithm
+
+// ErrVerificationFailed é o erro que implementações de Algorithm retornam de Verify quando a
+// assinatura não confere. Não é retornado pelo Parse, que o converte em ErrInvalidSignature.
+var ErrVerificationFailed = core.ErrVerificationFailed
+
+// PublicKeyEncoder pode ser implementado por um Algorithm cujas chaves públicas não sejam
+// []byte, para que RequireSignatures reconheça a mesma chave registrada sob kids distintos.
+// Sem ele, chaves que não sejam []byte não são contadas pelo limiar, que falha com
+// ErrUnsupportedAlgorithm.
+type PublicKeyEncoder = core.PublicKeyEncoder
+
+// AlgEd25519 identifica assinaturas Ed25519, o algoritmo padrão da especificação v1.0.
+// Tokens sem o campo alg são tratados como Ed25519.
+const AlgEd25519 = core.AlgEd25519
+
+// RegisterAlgorithm adiciona um algoritmo de assinatura ao registro global,
+// tornando-o disponível para SignWithAlgorithm e Parse.
+// Retorna erro se o identificador já estiver registrado.
+//
+// Exemplo:
+//
+//	if err := signet.RegisterAlgorithm(meuAlgoritmo); err != nil {
+//	    log.Fatal(err)
+//	}
+func RegisterAlgorithm(alg Algorithm) error {
+	return core.RegisterAlgorithm(alg)
+}
+
+// VerificationKey associa uma chave pública ao algoritmo para o qual ela foi registrada.
+// O Parse só usa a chave para verificar tokens que declaram exatamente esse algoritmo.
+type VerificationKey struct {
+	// Algorithm é o identificador do algoritmo (ex: AlgEd25519).
+	Algorithm string
+	// Key é a chave pública no tipo esperado pelo algoritmo (ex: ed25519.PublicKey).
+	Key crypto.PublicKey
+}
+
+// KeyRequest descreve a chave que o Parse precisa resolver para verificar um token.
+type KeyRequest struct {
+	// KeyID é o kid extraído do payload.
+	KeyID string
+	// Issuer é o iss extraído do payload (vazio se o token não declarar emissor).
+	// Permite que kids sejam únicos apenas dentro de cada emissor.
+	Issuer string
+	// Algorithm é o algoritmo declarado pelo token. Ainda não foi verificado:
+	// serve apenas para selecionar a chave quando um kid possui chaves de vários algoritmos.
+	Algorithm string
+}
+
+// KeyResolver resolve a chave pública, vinculada a um algoritmo, usada para verificar um token.
+// KeyResolverFunc e VerificationKeyResolverFunc implementam esta interface.
+type KeyResolver interface {
+	ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error)
+}
+
+// VerificationKeyResolverFunc adapta uma função comum para a interface KeyResolver,
+// permitindo retornar chaves de qualquer algoritmo registrado.
+//
+// Exemplo:
+//
+//	resolver := signet.VerificationKeyResolverFunc(func(ctx context.Context, req signet.KeyRequest) (signet.VerificationKey, error) {
+//	    key, ok := keyMap[req.KeyID]
+//	    if !ok {
+//	        return signet.VerificationKey{}, signet.ErrUnknownKeyID
+//	    }
+//	    return key, nil
+//	})
+type VerificationKeyResolverFunc func(ctx context.Context, req KeyRequest) (VerificationKey, error)
+
+// ResolveKey implementa KeyResolver.
+func (f VerificationKeyResolverFunc) ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+	return f(ctx, req)
+}
+
+// ResolveKey implementa KeyResolver. As chaves retornadas por uma KeyResolverFunc
+// são sempre vinculadas ao algoritmo Ed25519.
+func (f KeyResolverFunc) ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+	pub, err := f(ctx, req.KeyID)
+	if err != nil {
+		return VerificationKey{}, err
+	}
+	return VerificationKey{Algorithm: AlgEd25519, Key: pub}, nil
+}
+
+// tokenAlgorithm retorna o algoritmo declarado pelo token, aplicando o padrão da v1.0.
+func tokenAlgorithm(alg string) string {
+	if alg == "" {
+		return AlgEd25519
+	}
+	return alg
+}
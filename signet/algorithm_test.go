@@ -0,0 +1,145 @@
+package signet
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Testa que o token carrega o identificador do algoritmo usado na assinatura
+func TestSign_SetsAlgorithm(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	tokenBytes, err := NewPayload().Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	var token signetv1.SignetToken
+	if err := proto.Unmarshal(tokenBytes, &token); err != nil {
+		t.Fatalf("erro ao deserializar token: %v", err)
+	}
+	if token.Alg != AlgEd25519 {
+		t.Errorf("esperava alg %q, obteve %q", AlgEd25519, token.Alg)
+	}
+}
+
+// Testa que tokens sem o campo alg continuam sendo aceitos como Ed25519
+func TestParse_LegacyTokenWithoutAlgorithm(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	payloadBytes, _ := proto.Marshal(&signetv1.SignetPayload{Iat: 1, Exp: 2})
+	sig, _ := core.Sign(priv, payloadBytes)
+	tokenBytes, _ := proto.Marshal(&signetv1.SignetToken{Payload: payloadBytes, Signature: sig})
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	_, err := Parse(context.Background(), tokenBytes, keyResolver, WithSkipExpirationCheck())
+	if err != nil {
+		t.Errorf("token legado deveria ser aceito: %v", err)
+	}
+}
+
+// Testa a rejeição de algoritmos não registrados
+func TestSignWithAlgorithm_Unsupported(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	_, err := NewPayload().SignWithAlgorithm("none", priv)
+	if !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("esperava ErrUnsupportedAlgorithm, obteve: %v", err)
+	}
+}
+
+// testAlgorithm é um algoritmo registrado apenas nos testes, cuja "assinatura" é a própria chave.
+type testAlgorithm struct{}
+
+func (testAlgorithm) ID() string { return "test-alg" }
+
+func (testAlgorithm) Sign(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	key, ok := privateKey.([]byte)
+	if !ok {
+		return nil, ErrInvalidPrivateKey
+	}
+	return key, nil
+}
+
+func (testAlgorithm) Verify(publicKey crypto.PublicKey, data, signature []byte) error {
+	key, ok := publicKey.([]byte)
+	if !ok {
+		return ErrInvalidPublicKey
+	}
+	if string(key) != string(signature) {
+		return ErrVerificationFailed
+	}
+	return nil
+}
+
+// Testa o despacho por algoritmo e a rejeição de confusão de algoritmo
+func TestParseWithResolver_AlgorithmDispatch(t *testing.T) {
+	if err := RegisterAlgorithm(testAlgorithm{}); err != nil && !errors.Is(err, core.ErrAlgorithmAlreadyRegistered) {
+		t.Fatalf("erro ao registrar algoritmo: %v", err)
+	}
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	secret := []byte("segredo-de-teste")
+	customToken, err := NewPayload().WithKeyID("custom").SignWithAlgorithm("test-alg", secret)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	edToken, _ := NewPayload().WithKeyID("ed").Sign(priv)
+	keys := map[string]VerificationKey{
+		"custom": {Algorithm: "test-alg", Key: secret},
+		"ed":     {Algorithm: AlgEd25519, Key: pub},
+	}
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		key, ok := keys[req.KeyID]
+		if !ok {
+			return VerificationKey{}, ErrUnknownKeyID
+		}
+		return key, nil
+	})
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		resolver      KeyResolver
+		expectedError error
+	}{
+		{"Sucesso: algoritmo customizado", customToken, resolver, nil},
+		{"Sucesso: Ed25519", edToken, resolver, nil},
+		{"Falha: assinatura customizada inválida", customToken, VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+			return VerificationKey{Algorithm: "test-alg", Key: []byte("outro-segredo")}, nil
+		}), ErrInvalidSignature},
+		{"Falha: chave customizada de tipo inesperado", customToken, VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+			return VerificationKey{Algorithm: "test-alg", Key: "segredo-de-teste"}, nil
+		}), ErrInvalidPublicKey},
+		{"Falha: chave Ed25519 para token customizado", customToken, KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+			return pub, nil
+		}), ErrAlgorithmMismatch},
+		{"Falha: chave customizada para token Ed25519", edToken, VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+			return keys["custom"], nil
+		}), ErrAlgorithmMismatch},
+		{"Falha: kid desconhecido", func() []byte { tok, _ := NewPayload().WithKeyID("x").Sign(priv); return tok }(), resolver, ErrUnknownKeyID},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseWithResolver(context.Background(), tc.token, tc.resolver)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Token declarando algoritmo não registrado
+	var token signetv1.SignetToken
+	_ = proto.Unmarshal(edToken, &token)
+	token.Alg = "none"
+	unknownAlgToken, _ := proto.Marshal(&token)
+	_, err = ParseWithResolver(context.Background(), unknownAlgToken, resolver)
+	if !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("esperava ErrUnsupportedAlgorithm, obteve: %v", err)
+	}
+}
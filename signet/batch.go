@@ -0,0 +1,112 @@
+package signet
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// BatchResult é o resultado da validação de um token em ParseBatch.
+// Exatamente um entre Payload e Err é não nulo.
+type BatchResult struct {
+	Payload *signetv1.SignetPayload
+	Err     error
+}
+
+// WithBatchWorkers define o número máximo de tokens verificados em paralelo por ParseBatch.
+// O padrão é runtime.GOMAXPROCS(0). Não tem efeito em Parse.
+func WithBatchWorkers(n int) ValidationOption {
+	return func(c *validationConfig) {
+		c.batchWorkers = n
+	}
+}
+
+// ParseBatch valida um lote de tokens com as mesmas opções, retornando um resultado por token,
+// na mesma ordem de tokens. Cada token é validado exatamente como em ParseWithResolver, e as
+// métricas são registradas por token através do MetricsRecorder configurado.
+//
+// A chave de cada (kid, algoritmo) é resolvida uma única vez por lote, inclusive quando a
+// resolução falha, e os tokens são verificados em paralelo por um conjunto limitado de workers
+// (ver WithBatchWorkers). A verificação em lote do Ed25519 (equação agregada) não é usada:
+// suas regras de aceitação diferem das da verificação individual, e um mesmo token poderia ser
+// aceito em lote e rejeitado por Parse.
+//
+// As falhas de validação são *ValidationError, como em ParseWithResolver. Se o contexto for
+// cancelado, os tokens ainda não processados recebem ctx.Err() diretamente (não um
+// ValidationError, pois nenhuma validação ocorreu), sem registro de métrica.
+//
+// Exemplo:
+//
+//	results := signet.ParseBatch(ctx, tokens, resolver, signet.WithAudience("api-gateway"))
+//	for i, result := range results {
+//	    if result.Err != nil {
+//	        log.Printf("token %d rejeitado: %v", i, result.Err)
+//	    }
+//	}
+func ParseBatch(ctx context.Context, tokens [][]byte, keyResolver KeyResolver, options ...ValidationOption) []BatchResult {
+	config := newValidationConfig(options)
+	results := make([]BatchResult, len(tokens))
+	if len(tokens) == 0 {
+		return results
+	}
+	workers := config.batchWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(tokens))
+	resolver := &batchKeyCache{resolver: keyResolver, entries: make(map[KeyRequest]*batchKeyEntry)}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					results[i] = BatchResult{Err: err}
+					continue
+				}
+				payload, err := parseToken(ctx, tokens[i], resolver, config)
+				results[i] = BatchResult{Payload: payload, Err: err}
+			}
+		}()
+	}
+	for i := range tokens {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// batchKeyCache memoriza as chaves resolvidas durante um lote, garantindo uma única
+// chamada ao resolver por KeyRequest mesmo com workers concorrentes.
+type batchKeyCache struct {
+	resolver KeyResolver
+	mu       sync.Mutex
+	entries  map[KeyRequest]*batchKeyEntry
+}
+
+type batchKeyEntry struct {
+	once sync.Once
+	key  VerificationKey
+	err  error
+}
+
+// ResolveKey implementa KeyResolver.
+func (c *batchKeyCache) ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[req]
+	if !ok {
+		entry = &batchKeyEntry{}
+		c.entries[req] = entry
+	}
+	c.mu.Unlock()
+	entry.once.Do(func() {
+		entry.key, entry.err = c.resolver.ResolveKey(ctx, req)
+	})
+	return entry.key, entry.err
+}
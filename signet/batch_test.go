@@ -0,0 +1,138 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// countingRecorder conta as métricas registradas por razão.
+type countingRecorder struct {
+	mu      sync.Mutex
+	reasons map[string]int
+}
+
+func (r *countingRecorder) IncrementTokenValidation(ctx context.Context, success bool, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.reasons == nil {
+		r.reasons = make(map[string]int)
+	}
+	r.reasons[reason]++
+}
+
+// Testa resultados por token, resolução única por kid e métricas por token
+func TestParseBatch(t *testing.T) {
+	pubA, privA, _ := ed25519.GenerateKey(nil)
+	pubB, privB, _ := ed25519.GenerateKey(nil)
+	keys := map[string]ed25519.PublicKey{"a": pubA, "b": pubB}
+	var calls atomic.Int32
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		calls.Add(1)
+		key, ok := keys[kid]
+		if !ok {
+			return nil, ErrUnknownKeyID
+		}
+		return key, nil
+	})
+
+	var tokens [][]byte
+	var expected []error
+	for i := 0; i < 50; i++ {
+		tokA, _ := NewPayload().WithKeyID("a").WithSubject("user-a").Sign(privA)
+		tokB, _ := NewPayload().WithKeyID("b").WithSubject("user-b").Sign(privB)
+		tokens = append(tokens, tokA, tokB)
+		expected = append(expected, nil, nil)
+	}
+	wrongKey, _ := NewPayload().WithKeyID("a").Sign(privB)
+	unknown, _ := NewPayload().WithKeyID("c").Sign(privA)
+	expired, _ := NewPayload().WithKeyID("b").WithIssuedAt(1).WithExpiration(2).Sign(privB)
+	tokens = append(tokens, wrongKey, unknown, unknown, expired, []byte{0xFF})
+	expected = append(expected, ErrInvalidSignature, ErrUnknownKeyID, ErrUnknownKeyID, ErrTokenExpired, nil)
+
+	recorder := &countingRecorder{}
+	results := ParseBatch(context.Background(), tokens, resolver, WithMetricsRecorder(recorder), WithBatchWorkers(4))
+	if len(results) != len(tokens) {
+		t.Fatalf("esperava %d resultados, obteve %d", len(tokens), len(results))
+	}
+	for i, result := range results {
+		if i == len(results)-1 {
+			if result.Err == nil || result.Payload != nil {
+				t.Errorf("token %d malformado deveria falhar, obteve %v", i, result.Err)
+			}
+			continue
+		}
+		if !errors.Is(result.Err, expected[i]) {
+			t.Errorf("token %d: esperava erro '%v', mas obteve '%v'", i, expected[i], result.Err)
+		}
+		if expected[i] == nil && result.Payload == nil {
+			t.Errorf("token %d: payload ausente", i)
+		}
+	}
+	if results[0].Payload.Sub != "user-a" || results[1].Payload.Sub != "user-b" {
+		t.Error("resultados fora da ordem dos tokens")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("esperava 3 resoluções de chave (a, b, c), obteve %d", got)
+	}
+	if recorder.reasons[ReasonSuccess] != 100 || recorder.reasons[ReasonInvalidSignature] != 3 ||
+		recorder.reasons[ReasonTokenExpired] != 1 || recorder.reasons[ReasonInvalidPayload] != 1 {
+		t.Errorf("métricas por token inesperadas: %v", recorder.reasons)
+	}
+}
+
+// Testa lotes vazios e o cancelamento do contexto
+func TestParseBatch_EmptyAndCanceled(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	if results := ParseBatch(context.Background(), nil, resolver); len(results) != 0 {
+		t.Errorf("esperava nenhum resultado, obteve %d", len(results))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tokenBytes, _ := NewPayload().Sign(priv)
+	recorder := &countingRecorder{}
+	results := ParseBatch(ctx, [][]byte{tokenBytes, tokenBytes}, resolver, WithMetricsRecorder(recorder))
+	for i, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("token %d: esperava context.Canceled, obteve: %v", i, result.Err)
+		}
+		var verr *ValidationError
+		if errors.As(result.Err, &verr) {
+			t.Errorf("token %d: token não processado não deveria retornar ValidationError", i)
+		}
+	}
+	if len(recorder.reasons) != 0 {
+		t.Errorf("tokens não processados não deveriam registrar métricas: %v", recorder.reasons)
+	}
+}
+
+// BenchmarkParseBatch compara o lote com chamadas sequenciais a ParseWithResolver.
+func BenchmarkParseBatch(b *testing.B) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	tokens := make([][]byte, 1000)
+	for i := range tokens {
+		tokens[i], _ = NewPayload().WithKeyID("k").Sign(priv)
+	}
+	b.Run("ParseBatch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ParseBatch(context.Background(), tokens, resolver)
+		}
+	})
+	b.Run("Sequencial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, tok := range tokens {
+				_, _ = ParseWithResolver(context.Background(), tok, resolver)
+			}
+		}
+	})
+}
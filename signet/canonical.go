@@ -0,0 +1,115 @@
+package signet
+
+import (
+	"bytes"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// canonicalMarshal produz a codificação canônica usada na emissão de tokens: campos em ordem
+// crescente de número, varints mínimos, valores padrão omitidos e mapas ordenados por chave.
+var canonicalMarshal = proto.MarshalOptions{Deterministic: true}
+
+// WithStrictEncoding ativa a decodificação estrita: o Parse rejeita com ErrNonCanonicalEncoding
+// tokens cujos bytes diferem da codificação canônica (campos duplicados ou fora de ordem,
+// campos desconhecidos, varints não mínimos, valores padrão explícitos), que usam formas
+// alternativas de um mesmo valor (alg ou version omitidos, kid de assinatura adicional
+// repetindo o kid do payload) ou que carregam assinaturas adicionais inválidas ou repetidas,
+// mesmo que ignoradas pela política ativa. Codificações distintas com o mesmo significado
+// quebram caches, deduplicação e revogação baseados no hash do token.
+//
+// É o padrão em NewValidator; nas funções Parse*, deve ser solicitada explicitamente para
+// preservar a compatibilidade com tokens emitidos por versões anteriores.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithStrictEncoding())
+func WithStrictEncoding() ValidationOption {
+	return func(c *validationConfig) {
+		c.strictEncoding = true
+	}
+}
+
+// WithLenientEncoding desativa a decodificação estrita (ver WithStrictEncoding), aceitando
+// qualquer codificação protobuf válida do envelope e do payload. É o padrão nas funções
+// Parse*; em NewValidator, desativa o modo estrito padrão.
+//
+// Use apenas durante a migração de tokens emitidos por versões anteriores, que não
+// declaravam alg e version e cujos mapas (custom_claims, typed_claims) podiam ser
+// serializados fora de ordem.
+//
+// Exemplo:
+//
+//	validator, err := signet.NewValidator(resolver, signet.WithLenientEncoding())
+func WithLenientEncoding() ValidationOption {
+	return func(c *validationConfig) {
+		c.strictEncoding = false
+	}
+}
+
+// checkCanonical rejeita mensagens cuja codificação original difere da codificação canônica.
+// Campos desconhecidos são rejeitados explicitamente, pois seriam reemitidos intactos.
+func checkCanonical(name string, raw []byte, msg proto.Message) error {
+	if hasUnknownFields(msg.ProtoReflect()) {
+		return fmt.Errorf("%s com campos desconhecidos: %w", name, ErrNonCanonicalEncoding)
+	}
+	canonical, err := canonicalMarshal.Marshal(msg)
+	if err != nil || !bytes.Equal(canonical, raw) {
+		return fmt.Errorf("%s fora da codificação canônica: %w", name, ErrNonCanonicalEncoding)
+	}
+	return nil
+}
+
+// checkAliases rejeita as formas alternativas aceitas apenas por compatibilidade com tokens
+// legados: alg e version devem ser explícitos, e o kid de uma assinatura adicional é omitido
+// quando coincide com o kid do payload. Assim, cada token possui uma única codificação aceita.
+func checkAliases(token *signetv1.SignetToken, payload *signetv1.SignetPayload) error {
+	if token.Version == 0 {
+		return fmt.Errorf("SignetToken sem versão explícita: %w", ErrNonCanonicalEncoding)
+	}
+	if token.Alg == "" {
+		return fmt.Errorf("SignetToken sem algoritmo explícito: %w", ErrNonCanonicalEncoding)
+	}
+	for i, sig := range token.Signatures {
+		if sig.Alg == "" {
+			return fmt.Errorf("assinatura adicional %d sem algoritmo explícito: %w", i, ErrNonCanonicalEncoding)
+		}
+		if sig.Kid != "" && sig.Kid == payload.Kid {
+			return fmt.Errorf("assinatura adicional %d repete o kid do payload: %w", i, ErrNonCanonicalEncoding)
+		}
+	}
+	return nil
+}
+
+// hasUnknownFields percorre a mensagem e suas submensagens em busca de campos desconhecidos.
+func hasUnknownFields(m protoreflect.Message) bool {
+	if len(m.GetUnknown()) > 0 {
+		return true
+	}
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					found = hasUnknownFields(mv.Message())
+					return !found
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len() && !found; i++ {
+					found = hasUnknownFields(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			found = hasUnknownFields(v.Message())
+		}
+		return !found
+	})
+	return found
+}
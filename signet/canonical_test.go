@@ -0,0 +1,259 @@
+package signet
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// signRawPayload assina bytes de payload arbitrários e monta um envelope canônico.
+func signRawPayload(t *testing.T, priv ed25519.PrivateKey, payloadBytes []byte) []byte {
+	t.Helper()
+	message, err := core.SigningMessage(CurrentTokenVersion, "", payloadBytes)
+	if err != nil {
+		t.Fatalf("erro ao montar mensagem assinada: %v", err)
+	}
+	tokenBytes, err := canonicalMarshal.Marshal(&signetv1.SignetToken{
+		Payload:   payloadBytes,
+		Signature: ed25519.Sign(priv, message),
+		Alg:       AlgEd25519,
+		Version:   CurrentTokenVersion,
+	})
+	if err != nil {
+		t.Fatalf("erro ao serializar token: %v", err)
+	}
+	return tokenBytes
+}
+
+// mapEntry codifica uma entrada de map<string, string> no campo informado.
+func mapEntry(field protowire.Number, key, value string) []byte {
+	var entry []byte
+	entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, key)
+	entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+	entry = protowire.AppendString(entry, value)
+	b := protowire.AppendTag(nil, field, protowire.BytesType)
+	return protowire.AppendBytes(b, entry)
+}
+
+// Testa a rejeição de codificações protobuf não canônicas do envelope e do payload
+func TestParse_CanonicalEncoding(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	exp := uint64(time.Now().Add(time.Hour).Unix())
+	base := protowire.AppendTag(nil, 1, protowire.VarintType)
+	base = protowire.AppendVarint(base, exp)
+
+	canonical := signRawPayload(t, priv, append(append([]byte(nil), base...), mapEntry(6, "a", "1")...))
+
+	// Envelope: campo alg duplicado e campo desconhecido
+	duplicateAlg := protowire.AppendTag(append([]byte(nil), canonical...), 3, protowire.BytesType)
+	duplicateAlg = protowire.AppendString(duplicateAlg, AlgEd25519)
+	unknownEnvelope := protowire.AppendTag(append([]byte(nil), canonical...), 99, protowire.VarintType)
+	unknownEnvelope = protowire.AppendVarint(unknownEnvelope, 1)
+
+	// Payload: varint não mínimo, mapa fora de ordem, valor padrão explícito e campo desconhecido
+	overlongExp := protowire.AppendTag(nil, 1, protowire.VarintType)
+	overlongExp = protowire.AppendVarint(overlongExp, exp)
+	overlongExp[len(overlongExp)-1] |= 0x80 // mesmo valor com um byte de continuação extra
+	overlongExp = append(overlongExp, 0x00)
+	unorderedMap := append(append(append([]byte(nil), base...), mapEntry(6, "b", "2")...), mapEntry(6, "a", "1")...)
+	explicitDefault := protowire.AppendTag(append([]byte(nil), base...), 3, protowire.BytesType)
+	explicitDefault = protowire.AppendString(explicitDefault, "")
+	unknownPayload := protowire.AppendTag(append([]byte(nil), base...), 99, protowire.VarintType)
+	unknownPayload = protowire.AppendVarint(unknownPayload, 7)
+	typedClaim, _ := proto.Marshal(&signetv1.ClaimValue{Kind: &signetv1.ClaimValue_IntValue{IntValue: 5}})
+	typedClaim = protowire.AppendTag(typedClaim, 99, protowire.VarintType)
+	typedClaim = protowire.AppendVarint(typedClaim, 7)
+	var typedEntry []byte
+	typedEntry = protowire.AppendTag(typedEntry, 1, protowire.BytesType)
+	typedEntry = protowire.AppendString(typedEntry, "limite")
+	typedEntry = protowire.AppendTag(typedEntry, 2, protowire.BytesType)
+	typedEntry = protowire.AppendBytes(typedEntry, typedClaim)
+	nestedUnknown := protowire.AppendTag(append([]byte(nil), base...), 13, protowire.BytesType)
+	nestedUnknown = protowire.AppendBytes(nestedUnknown, typedEntry)
+
+	nonCanonical := []struct {
+		name  string
+		token []byte
+	}{
+		{"envelope com campo duplicado", duplicateAlg},
+		{"envelope com campo desconhecido", unknownEnvelope},
+		{"payload com varint não mínimo", signRawPayload(t, priv, overlongExp)},
+		{"payload com mapa fora de ordem", signRawPayload(t, priv, unorderedMap)},
+		{"payload com valor padrão explícito", signRawPayload(t, priv, explicitDefault)},
+		{"payload com campo desconhecido", signRawPayload(t, priv, unknownPayload)},
+		{"payload com campo desconhecido em claim tipado", signRawPayload(t, priv, nestedUnknown)},
+	}
+	if _, err := Parse(context.Background(), canonical, keyResolver, WithStrictEncoding()); err != nil {
+		t.Fatalf("token canônico rejeitado: %v", err)
+	}
+	for _, tc := range nonCanonical {
+		t.Run("Falha: "+tc.name, func(t *testing.T) {
+			if _, err := Parse(context.Background(), tc.token, keyResolver, WithStrictEncoding()); !errors.Is(err, ErrNonCanonicalEncoding) {
+				t.Errorf("esperava ErrNonCanonicalEncoding, obteve: %v", err)
+			}
+		})
+		t.Run("Sucesso: "+tc.name+" sem WithStrictEncoding", func(t *testing.T) {
+			if _, err := Parse(context.Background(), tc.token, keyResolver); err != nil {
+				t.Errorf("esperava sucesso no modo tolerante padrão do Parse, obteve: %v", err)
+			}
+		})
+		t.Run("Falha: "+tc.name+" no Validator", func(t *testing.T) {
+			validator, _ := NewValidator(KeyResolverFunc(keyResolver))
+			if _, err := validator.Validate(context.Background(), tc.token); !errors.Is(err, ErrNonCanonicalEncoding) {
+				t.Errorf("esperava ErrNonCanonicalEncoding no modo estrito padrão do Validator, obteve: %v", err)
+			}
+		})
+		t.Run("Sucesso: "+tc.name+" no Validator com WithLenientEncoding", func(t *testing.T) {
+			validator, _ := NewValidator(KeyResolverFunc(keyResolver), WithLenientEncoding())
+			if _, err := validator.Validate(context.Background(), tc.token); err != nil {
+				t.Errorf("esperava sucesso no modo tolerante, obteve: %v", err)
+			}
+		})
+	}
+}
+
+// Testa que, no modo estrito, apenas a forma emitida pelo Sign é aceita, e não as formas
+// alternativas de alg, version e kid aceitas por compatibilidade com tokens legados
+func TestParse_CanonicalAliases(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	pqPub, pqPriv, _ := mldsa65.GenerateKey(nil)
+	keyResolver := HybridKeyResolver(
+		KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) { return pub, nil }),
+		MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) { return pqPub.Bytes(), nil }),
+	)
+	signed, err := NewPayload().WithKeyID("k1").Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	hybrid, err := NewPayload().WithKeyID("k1").SignHybrid(priv, pqPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	reencode := func(tokenBytes []byte, mutate func(*signetv1.SignetToken)) []byte {
+		var token signetv1.SignetToken
+		_ = proto.Unmarshal(tokenBytes, &token)
+		mutate(&token)
+		out, _ := canonicalMarshal.Marshal(&token)
+		return out
+	}
+
+	testCases := []struct {
+		name          string
+		original      []byte
+		alias         []byte
+		expectedError error
+	}{
+		{"alg omitido", signed, reencode(signed, func(tok *signetv1.SignetToken) { tok.Alg = "" }), ErrNonCanonicalEncoding},
+		{"alg e version omitidos", signed, reencode(signed, func(tok *signetv1.SignetToken) { tok.Alg, tok.Version = "", 0 }), ErrNonCanonicalEncoding},
+		{"alg de assinatura adicional omitido", hybrid, reencode(hybrid, func(tok *signetv1.SignetToken) { tok.Signatures[0].Alg = "" }), ErrNonCanonicalEncoding},
+		{"kid do payload explícito em assinatura adicional", hybrid, reencode(hybrid, func(tok *signetv1.SignetToken) { tok.Signatures[0].Kid = "k1" }), ErrNonCanonicalEncoding},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if bytes.Equal(tc.original, tc.alias) {
+				t.Fatal("a forma alternativa deveria ter bytes distintos")
+			}
+			if _, err := ParseWithResolver(context.Background(), tc.original, keyResolver, WithStrictEncoding()); err != nil {
+				t.Fatalf("forma emitida pelo Sign rejeitada: %v", err)
+			}
+			if _, err := ParseWithResolver(context.Background(), tc.alias, keyResolver, WithStrictEncoding()); !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v' para a forma alternativa, obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+// Testa que o modo estrito rejeita assinaturas adicionais não verificáveis ou repetidas,
+// que permitiriam gerar codificações canônicas distintas do mesmo token
+func TestValidator_AdditionalSignatures(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	pqPub, pqPriv, _ := mldsa65.GenerateKey(nil)
+	resolver := HybridKeyResolver(
+		KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) { return pub, nil }),
+		MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) { return pqPub.Bytes(), nil }),
+	)
+	classical, _ := NewPayload().WithKeyID("k1").Sign(priv)
+	hybrid, _ := NewPayload().WithKeyID("k1").SignHybrid(priv, pqPriv)
+	appendSignature := func(tokenBytes []byte, sig *signetv1.SignetSignature) []byte {
+		var token signetv1.SignetToken
+		_ = proto.Unmarshal(tokenBytes, &token)
+		token.Signatures = append(token.Signatures, sig)
+		out, _ := canonicalMarshal.Marshal(&token)
+		return out
+	}
+	var hybridToken signetv1.SignetToken
+	_ = proto.Unmarshal(hybrid, &hybridToken)
+	pqSignature := hybridToken.Signatures[0]
+
+	validator, err := NewValidator(resolver)
+	if err != nil {
+		t.Fatalf("erro ao criar validador: %v", err)
+	}
+	testCases := []struct {
+		name          string
+		token         []byte
+		expectedError error
+	}{
+		{"Sucesso: token clássico", classical, nil},
+		{"Sucesso: token híbrido com ambas as assinaturas válidas", hybrid, nil},
+		{"Falha: assinatura anexada com algoritmo desconhecido", appendSignature(classical, &signetv1.SignetSignature{Alg: "junk", Signature: []byte{1}, Kid: "zz"}), ErrNonCanonicalEncoding},
+		{"Falha: outra assinatura anexada com algoritmo desconhecido", appendSignature(classical, &signetv1.SignetSignature{Alg: "junk", Signature: []byte{1, 2}, Kid: "zz"}), ErrNonCanonicalEncoding},
+		{"Falha: assinatura Ed25519 inválida anexada", appendSignature(classical, &signetv1.SignetSignature{Alg: AlgEd25519, Signature: make([]byte, 64), Kid: "zz"}), ErrNonCanonicalEncoding},
+		{"Falha: assinatura HMAC anexada", appendSignature(classical, &signetv1.SignetSignature{Alg: AlgHS256, Signature: make([]byte, 32), Kid: "zz"}), ErrNonCanonicalEncoding},
+		{"Falha: assinatura pós-quântica repetida", appendSignature(hybrid, pqSignature), ErrNonCanonicalEncoding},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := validator.Validate(context.Background(), tc.token); !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Sem chave pós-quântica resolvível, o modo estrito não aceita a assinatura adicional
+	classicalOnly, _ := NewValidator(KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) { return pub, nil }))
+	if _, err := classicalOnly.Validate(context.Background(), hybrid); !errors.Is(err, ErrNonCanonicalEncoding) {
+		t.Errorf("esperava ErrNonCanonicalEncoding sem chave pós-quântica, obteve: %v", err)
+	}
+	// O modo tolerante mantém o comportamento anterior, ignorando as assinaturas adicionais
+	junk := appendSignature(classical, &signetv1.SignetSignature{Alg: "junk", Signature: []byte{1}, Kid: "zz"})
+	if _, err := ParseWithResolver(context.Background(), junk, resolver); err != nil {
+		t.Errorf("modo tolerante deveria ignorar a assinatura adicional, obteve: %v", err)
+	}
+}
+
+// Testa que Sign produz sempre a mesma codificação canônica
+func TestSign_DeterministicEncoding(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	build := func() *PayloadBuilder {
+		b := NewPayload().WithIssuedAt(1700000000).WithExpiration(1700000900).WithTokenID([]byte("jti"))
+		for _, k := range []string{"e", "d", "c", "b", "a"} {
+			b.WithCustomClaim(k, k).WithClaimInt("n"+k, 1)
+		}
+		return b
+	}
+	first, err := build().Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	for i := 0; i < 20; i++ {
+		again, _ := build().Sign(priv)
+		if !bytes.Equal(first, again) {
+			t.Fatal("Sign produziu codificações distintas para o mesmo payload")
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package signet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// claimCheck é uma validação de claim executada após a verificação da assinatura.
+// Parse interrompe na primeira falha; Explain executa todas.
+type claimCheck struct {
+	// name identifica a validação no relatório do Explain (ex: "exp", "aud").
+	name string
+	// run executa a validação, retornando a razão de métrica e o erro em caso de falha.
+	run func() (string, error)
+	// values descreve os valores relevantes para o relatório do Explain.
+	values func() string
+}
+
+// claimChecks retorna as validações de claims ativas na configuração, na ordem em que
+// Parse as executa. A proteção contra replay não faz parte da lista: ela consome o jti.
+func claimChecks(payload *signetv1.SignetPayload, config *validationConfig, now time.Time) []claimCheck {
+	earliest := now.Add(-config.leeway).Unix()
+	latest := now.Add(config.leeway).Unix()
+	checks := make([]claimCheck, 0, 10)
+	if !config.skipExpirationCheck {
+		checks = append(checks, claimCheck{
+			name: "exp",
+			run: func() (string, error) {
+				if payload.Exp <= earliest {
+					return ReasonTokenExpired, ErrTokenExpired
+				}
+				return "", nil
+			},
+			values: func() string {
+				return fmt.Sprintf("exp=%d agora=%d tolerância=%v", payload.Exp, now.Unix(), config.leeway)
+			},
+		})
+	}
+	if !config.skipIssuedAtCheck {
+		checks = append(checks, claimCheck{
+			name: "iat",
+			run: func() (string, error) {
+				if payload.Iat > latest {
+					return ReasonTokenNotYetValid, ErrTokenNotYetValid
+				}
+				return "", nil
+			},
+			values: func() string {
+				return fmt.Sprintf("iat=%d agora=%d tolerância=%v", payload.Iat, now.Unix(), config.leeway)
+			},
+		})
+	}
+	checks = append(checks, claimCheck{
+		name: "nbf",
+		run: func() (string, error) {
+			if payload.Nbf > latest {
+				return ReasonTokenNotBefore, ErrTokenNotBefore
+			}
+			return "", nil
+		},
+		values: func() string {
+			return fmt.Sprintf("nbf=%d agora=%d tolerância=%v", payload.Nbf, now.Unix(), config.leeway)
+		},
+	})
+	if config.maxLifetime > 0 {
+		checks = append(checks, claimCheck{
+			name: "max_lifetime",
+			run:  func() (string, error) { return checkMaxLifetime(payload, config) },
+			values: func() string {
+				return fmt.Sprintf("validade=%v máximo=%v", lifetime(payload), config.maxLifetime)
+			},
+		})
+	}
+	if config.maxAge > 0 {
+		checks = append(checks, claimCheck{
+			name: "max_age",
+			run:  func() (string, error) { return checkMaxAge(payload, config, now) },
+			values: func() string {
+				return fmt.Sprintf("idade=%v máximo=%v", tokenAge(payload, config, now), config.maxAge)
+			},
+		})
+	}
+	if config.expectedAudience != "" {
+		checks = append(checks, claimCheck{
+			name: "aud",
+			run: func() (string, error) {
+				if !hasAudience(payload, config.expectedAudience) {
+					return ReasonAudienceMismatch, ErrAudienceMismatch
+				}
+				return "", nil
+			},
+			values: func() string {
+				return fmt.Sprintf("esperada=%q token=%q", config.expectedAudience, Audiences(payload))
+			},
+		})
+	}
+	if len(config.requiredRoles) > 0 {
+		checks = append(checks, claimCheck{
+			name: "roles",
+			run: func() (string, error) {
+				if len(missingRoles(payload, config.requiredRoles)) > 0 {
+					return ReasonMissingRequiredRole, ErrMissingRequiredRole
+				}
+				return "", nil
+			},
+			values: func() string {
+				return fmt.Sprintf("requeridos=%q token=%q ausentes=%q", config.requiredRoles, payload.Roles, missingRoles(payload, config.requiredRoles))
+			},
+		})
+	}
+	if config.revocationChecker != nil && len(payload.Sid) > 0 {
+		checks = append(checks, claimCheck{
+			name: "sid",
+			run: func() (string, error) {
+				if config.revocationChecker(payload.Sid) {
+					return ReasonTokenRevoked, ErrTokenRevoked
+				}
+				return "", nil
+			},
+			values: func() string { return fmt.Sprintf("sid=%x", payload.Sid) },
+		})
+	}
+	if config.extension != nil {
+		checks = append(checks, claimCheck{
+			name: "extension",
+			run:  func() (string, error) { return unmarshalExtension(payload, config.extension) },
+			values: func() string {
+				return fmt.Sprintf("esperada=%s token=%q", config.extension.ProtoReflect().Descriptor().FullName(),
+					strings.TrimPrefix(payload.Extension.GetTypeUrl(), "type.googleapis.com/"))
+			},
+		})
+	}
+	return checks
+}
+
+// missingRoles retorna os papéis requeridos ausentes no payload.
+func missingRoles(payload *signetv1.SignetPayload, required []string) []string {
+	roles := make(map[string]struct{}, len(payload.Roles))
+	for _, r := range payload.Roles {
+		roles[r] = struct{}{}
+	}
+	var missing []string
+	for _, r := range required {
+		if _, ok := roles[r]; !ok {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
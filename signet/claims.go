@@ -0,0 +1,104 @@
+package signet
+
+import (
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// WithClaimInt adiciona um claim customizado inteiro ao payload.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithClaimInt("limite_diario", 5000)
+func (b *PayloadBuilder) WithClaimInt(key string, value int64) *PayloadBuilder {
+	return b.withTypedClaim(key, &signetv1.ClaimValue{Kind: &signetv1.ClaimValue_IntValue{IntValue: value}})
+}
+
+// WithClaimBool adiciona um claim customizado booleano ao payload.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithClaimBool("mfa", true)
+func (b *PayloadBuilder) WithClaimBool(key string, value bool) *PayloadBuilder {
+	return b.withTypedClaim(key, &signetv1.ClaimValue{Kind: &signetv1.ClaimValue_BoolValue{BoolValue: value}})
+}
+
+// WithClaimStrings adiciona um claim customizado do tipo lista de strings ao payload.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithClaimStrings("regioes", "br", "us")
+func (b *PayloadBuilder) WithClaimStrings(key string, values ...string) *PayloadBuilder {
+	list := &signetv1.StringList{Values: append([]string(nil), values...)}
+	return b.withTypedClaim(key, &signetv1.ClaimValue{Kind: &signetv1.ClaimValue_StringsValue{StringsValue: list}})
+}
+
+// withTypedClaim grava o claim tipado, removendo um claim string de mesma chave
+// para que cada chave apareça em apenas um dos mapas.
+func (b *PayloadBuilder) withTypedClaim(key string, value *signetv1.ClaimValue) *PayloadBuilder {
+	if b.payload.TypedClaims == nil {
+		b.payload.TypedClaims = make(map[string]*signetv1.ClaimValue)
+	}
+	delete(b.payload.CustomClaims, key)
+	b.payload.TypedClaims[key] = value
+	return b
+}
+
+// ClaimString retorna o claim customizado string, definido via WithCustomClaim ou
+// como valor tipado string por outros emissores.
+// O segundo retorno é false se o claim estiver ausente ou tiver outro tipo.
+//
+// Exemplo:
+//
+//	tenant, ok := signet.ClaimString(payload, "tenant")
+func ClaimString(payload *signetv1.SignetPayload, key string) (string, bool) {
+	if value, ok := payload.GetCustomClaims()[key]; ok {
+		return value, true
+	}
+	kind, ok := payload.GetTypedClaims()[key].GetKind().(*signetv1.ClaimValue_StringValue)
+	if !ok {
+		return "", false
+	}
+	return kind.StringValue, true
+}
+
+// ClaimInt retorna o claim customizado inteiro, definido via WithClaimInt.
+// O segundo retorno é false se o claim estiver ausente ou tiver outro tipo.
+//
+// Exemplo:
+//
+//	limite, ok := signet.ClaimInt(payload, "limite_diario")
+func ClaimInt(payload *signetv1.SignetPayload, key string) (int64, bool) {
+	kind, ok := payload.GetTypedClaims()[key].GetKind().(*signetv1.ClaimValue_IntValue)
+	if !ok {
+		return 0, false
+	}
+	return kind.IntValue, true
+}
+
+// ClaimBool retorna o claim customizado booleano, definido via WithClaimBool.
+// O segundo retorno é false se o claim estiver ausente ou tiver outro tipo.
+//
+// Exemplo:
+//
+//	mfa, ok := signet.ClaimBool(payload, "mfa")
+func ClaimBool(payload *signetv1.SignetPayload, key string) (bool, bool) {
+	kind, ok := payload.GetTypedClaims()[key].GetKind().(*signetv1.ClaimValue_BoolValue)
+	if !ok {
+		return false, false
+	}
+	return kind.BoolValue, true
+}
+
+// ClaimStrings retorna o claim customizado do tipo lista de strings, definido via WithClaimStrings.
+// O segundo retorno é false se o claim estiver ausente ou tiver outro tipo.
+//
+// Exemplo:
+//
+//	regioes, ok := signet.ClaimStrings(payload, "regioes")
+func ClaimStrings(payload *signetv1.SignetPayload, key string) ([]string, bool) {
+	kind, ok := payload.GetTypedClaims()[key].GetKind().(*signetv1.ClaimValue_StringsValue)
+	if !ok {
+		return nil, false
+	}
+	return kind.StringsValue.GetValues(), true
+}
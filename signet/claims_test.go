@@ -0,0 +1,84 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Testa o round-trip de claims tipados e a convivência com claims string
+func TestTypedClaims_RoundTrip(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	tokenBytes, err := NewPayload().
+		WithCustomClaim("tenant", "acme").
+		WithClaimInt("limite_diario", 1<<60).
+		WithClaimBool("mfa", true).
+		WithClaimStrings("regioes", "br", "us").
+		Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	payload, err := Parse(context.Background(), tokenBytes, keyResolver)
+	if err != nil {
+		t.Fatalf("erro ao validar: %v", err)
+	}
+
+	if tenant, ok := ClaimString(payload, "tenant"); !ok || tenant != "acme" {
+		t.Errorf("claim string: esperava 'acme', obteve %q (%v)", tenant, ok)
+	}
+	if payload.CustomClaims["tenant"] != "acme" {
+		t.Error("claims string devem continuar acessíveis via CustomClaims")
+	}
+	if limite, ok := ClaimInt(payload, "limite_diario"); !ok || limite != 1<<60 {
+		t.Errorf("claim inteiro: esperava %d, obteve %d (%v)", int64(1<<60), limite, ok)
+	}
+	if mfa, ok := ClaimBool(payload, "mfa"); !ok || !mfa {
+		t.Errorf("claim booleano: esperava true, obteve %v (%v)", mfa, ok)
+	}
+	if regioes, ok := ClaimStrings(payload, "regioes"); !ok || len(regioes) != 2 || regioes[1] != "us" {
+		t.Errorf("claim lista: esperava [br us], obteve %v (%v)", regioes, ok)
+	}
+}
+
+// Testa os getters para claims ausentes ou de outro tipo
+func TestTypedClaims_Getters(t *testing.T) {
+	payload, _ := NewPayload().WithClaimInt("n", 1).WithCustomClaim("s", "x").Build()
+	payload.TypedClaims["ts"] = &signetv1.ClaimValue{Kind: &signetv1.ClaimValue_StringValue{StringValue: "tipado"}}
+
+	testCases := []struct {
+		name string
+		ok   bool
+	}{
+		{"ClaimInt ausente", func() bool { _, ok := ClaimInt(payload, "x"); return ok }()},
+		{"ClaimInt de outro tipo", func() bool { _, ok := ClaimInt(payload, "s"); return ok }()},
+		{"ClaimBool de inteiro", func() bool { _, ok := ClaimBool(payload, "n"); return ok }()},
+		{"ClaimStrings de inteiro", func() bool { _, ok := ClaimStrings(payload, "n"); return ok }()},
+		{"ClaimString de inteiro", func() bool { _, ok := ClaimString(payload, "n"); return ok }()},
+		{"ClaimString em payload nulo", func() bool { _, ok := ClaimString(nil, "s"); return ok }()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.ok {
+				t.Error("getter deveria retornar false")
+			}
+		})
+	}
+	if s, ok := ClaimString(payload, "ts"); !ok || s != "tipado" {
+		t.Errorf("ClaimString deveria ler valores tipados string, obteve %q (%v)", s, ok)
+	}
+
+	// Cada chave aparece em apenas um dos mapas: a última escrita prevalece
+	payload, _ = NewPayload().WithCustomClaim("k", "texto").WithClaimInt("k", 7).Build()
+	if _, ok := payload.CustomClaims["k"]; ok {
+		t.Error("WithClaimInt deveria remover o claim string de mesma chave")
+	}
+	payload, _ = NewPayload().WithClaimInt("k", 7).WithCustomClaim("k", "texto").Build()
+	if _, ok := payload.TypedClaims["k"]; ok {
+		t.Error("WithCustomClaim deveria remover o claim tipado de mesma chave")
+	}
+}
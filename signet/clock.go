@@ -0,0 +1,51 @@
+package signet
+
+import "time"
+
+// Clock fornece o instante atual usado nas validações temporais (exp, iat, nbf).
+// Permite controlar o tempo em testes sem recorrer a WithSkipExpirationCheck
+// (ver signettest.FakeClock).
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock é o Clock padrão, baseado em time.Now.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// WithClock define o relógio usado nas validações temporais. Um relógio nulo equivale ao
+// relógio do sistema.
+//
+// Exemplo:
+//
+//	clock := signettest.NewFakeClock(time.Unix(1700000000, 0))
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithClock(clock))
+func WithClock(clock Clock) ValidationOption {
+	return func(c *validationConfig) {
+		c.clock = clock
+	}
+}
+
+// WithLeeway define a tolerância a diferenças de relógio entre emissor e validador, aplicada
+// às verificações de exp (aceito até exp + leeway), iat e nbf (aceitos a partir de
+// iat - leeway e nbf - leeway). Com WithReplayProtection, o jti é retido até exp + leeway.
+// Tolerâncias de poucos segundos a um minuto costumam bastar; valores negativos são
+// recusados por NewValidator.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithLeeway(30*time.Second))
+func WithLeeway(leeway time.Duration) ValidationOption {
+	return func(c *validationConfig) {
+		c.leeway = leeway
+	}
+}
+
+// now retorna o instante atual segundo o relógio configurado.
+func (c *validationConfig) now() time.Time {
+	if c.clock == nil {
+		return time.Now()
+	}
+	return c.clock.Now()
+}
@@ -0,0 +1,84 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucas-de-lima/signet-go/signet/signettest"
+)
+
+// Testa as validações temporais com relógio injetado e tolerância de relógio
+func TestParse_ClockAndLeeway(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	start := time.Unix(1700000000, 0)
+	iat := start.Unix()
+	tokenBytes, err := NewPayload().
+		WithIssuedAt(iat).
+		WithNotBefore(iat + 60).
+		WithExpiration(iat + 600).
+		Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	issuedAhead, _ := NewPayload().WithIssuedAt(iat + 20).WithExpiration(iat + 600).Sign(priv)
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		offset        time.Duration
+		leeway        time.Duration
+		expectedError error
+	}{
+		{"Falha: antes do nbf", tokenBytes, 30 * time.Second, 0, ErrTokenNotBefore},
+		{"Sucesso: nbf dentro da tolerância", tokenBytes, 30 * time.Second, 30 * time.Second, nil},
+		{"Sucesso: dentro da validade", tokenBytes, 5 * time.Minute, 0, nil},
+		{"Falha: exatamente no exp", tokenBytes, 10 * time.Minute, 0, ErrTokenExpired},
+		{"Sucesso: exp dentro da tolerância", tokenBytes, 10*time.Minute + 20*time.Second, 30 * time.Second, nil},
+		{"Falha: exp além da tolerância", tokenBytes, 10*time.Minute + 30*time.Second, 30 * time.Second, ErrTokenExpired},
+		{"Falha: iat no futuro por desvio de relógio", issuedAhead, 0, 0, ErrTokenNotYetValid},
+		{"Sucesso: iat no futuro dentro da tolerância", issuedAhead, 0, 30 * time.Second, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := signettest.NewFakeClock(start.Add(tc.offset))
+			_, err := Parse(context.Background(), tc.token, keyResolver, WithClock(clock), WithLeeway(tc.leeway))
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+// Testa a proteção contra replay com relógio injetado: o jti é retido até exp + leeway
+func TestParse_ReplayWithClock(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	clock := signettest.NewFakeClock(time.Unix(1700000000, 0))
+	store := NewMemoryReplayStoreWithClock(clock)
+	options := []ValidationOption{WithClock(clock), WithLeeway(time.Minute), WithReplayProtection(store)}
+	tokenBytes, _ := NewPayload().
+		WithIssuedAt(clock.Now().Unix()).
+		WithExpiration(clock.Now().Add(time.Minute).Unix()).
+		Sign(priv)
+
+	if _, err := Parse(context.Background(), tokenBytes, keyResolver, options...); err != nil {
+		t.Fatalf("primeiro uso rejeitado: %v", err)
+	}
+	// Após exp, mas dentro da tolerância, o token ainda é aceito e o jti continua retido
+	clock.Advance(90 * time.Second)
+	if _, err := Parse(context.Background(), tokenBytes, keyResolver, options...); !errors.Is(err, ErrTokenReplayed) {
+		t.Errorf("esperava ErrTokenReplayed dentro da tolerância, obteve: %v", err)
+	}
+	clock.Advance(time.Minute)
+	if _, err := Parse(context.Background(), tokenBytes, keyResolver, options...); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("esperava ErrTokenExpired após a tolerância, obteve: %v", err)
+	}
+}
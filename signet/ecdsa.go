@@ -0,0 +1,68 @@
+package signet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+)
+
+// Identificadores dos algoritmos ECDSA suportados.
+// As assinaturas usam codificação de tamanho fixo (r||s) e são normalizadas para low-S.
+const (
+	// AlgES256 identifica ECDSA sobre a curva P-256 com SHA-256 (assinatura de 64 bytes).
+	AlgES256 = core.AlgES256
+	// AlgES384 identifica ECDSA sobre a curva P-384 com SHA-384 (assinatura de 96 bytes).
+	AlgES384 = core.AlgES384
+)
+
+// SignECDSA assina o payload com ECDSA, selecionando o algoritmo pela curva da chave
+// (P-256 → ES256, P-384 → ES384).
+//
+// Exemplo:
+//
+//	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+//	tokenBytes, err := signet.NewPayload().WithKeyID("ec-v1").SignECDSA(privateKey)
+func (b *PayloadBuilder) SignECDSA(privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	if privateKey == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	alg, err := core.ECDSAAlgorithmForCurve(privateKey.Curve)
+	if err != nil {
+		return nil, fmt.Errorf("curva ECDSA não suportada: %w", ErrUnsupportedAlgorithm)
+	}
+	return b.SignWithAlgorithm(alg, privateKey)
+}
+
+// ECDSAKeyResolverFunc é o análogo de KeyResolverFunc para chaves ECDSA.
+// A chave retornada é vinculada ao algoritmo correspondente à sua curva
+// (P-256 → ES256, P-384 → ES384); tokens que declaram outro algoritmo são rejeitados.
+//
+// Exemplo:
+//
+//	resolver := signet.ECDSAKeyResolverFunc(func(ctx context.Context, kid string) (*ecdsa.PublicKey, error) {
+//	    key, ok := ecKeys[kid]
+//	    if !ok {
+//	        return nil, signet.ErrUnknownKeyID
+//	    }
+//	    return key, nil
+//	})
+//	payload, err := signet.ParseWithResolver(ctx, tokenBytes, resolver)
+type ECDSAKeyResolverFunc func(ctx context.Context, kid string) (*ecdsa.PublicKey, error)
+
+// ResolveKey implementa KeyResolver.
+func (f ECDSAKeyResolverFunc) ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+	pub, err := f(ctx, req.KeyID)
+	if err != nil {
+		return VerificationKey{}, err
+	}
+	if pub == nil {
+		return VerificationKey{}, ErrInvalidPublicKey
+	}
+	alg, err := core.ECDSAAlgorithmForCurve(pub.Curve)
+	if err != nil {
+		return VerificationKey{}, fmt.Errorf("curva ECDSA não suportada: %w", ErrUnsupportedAlgorithm)
+	}
+	return VerificationKey{Algorithm: alg, Key: pub}, nil
+}
@@ -0,0 +1,76 @@
+package signet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+// Testa assinatura e validação round-trip com ECDSA P-256 e P-384
+func TestSignECDSA_RoundTrip(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384()} {
+		t.Run(curve.Params().Name, func(t *testing.T) {
+			priv, _ := ecdsa.GenerateKey(curve, rand.Reader)
+			tokenBytes, err := NewPayload().WithSubject("user-ec").WithKeyID("ec-v1").SignECDSA(priv)
+			if err != nil {
+				t.Fatalf("erro ao assinar: %v", err)
+			}
+			resolver := ECDSAKeyResolverFunc(func(ctx context.Context, kid string) (*ecdsa.PublicKey, error) {
+				return &priv.PublicKey, nil
+			})
+			payload, err := ParseWithResolver(context.Background(), tokenBytes, resolver)
+			if err != nil {
+				t.Fatalf("erro ao validar token: %v", err)
+			}
+			if payload.Sub != "user-ec" {
+				t.Error("payload retornado não bate com o original")
+			}
+		})
+	}
+}
+
+// Testa a rejeição de chaves de outra curva ou algoritmo para tokens ECDSA
+func TestParse_ECDSAKeyMismatch(t *testing.T) {
+	p256, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	edPub, _, _ := ed25519.GenerateKey(nil)
+	tokenBytes, err := NewPayload().SignECDSA(p256)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		resolver      KeyResolver
+		expectedError error
+	}{
+		{"Falha: chave P-384 para token ES256", ECDSAKeyResolverFunc(func(ctx context.Context, kid string) (*ecdsa.PublicKey, error) {
+			return &p384.PublicKey, nil
+		}), ErrAlgorithmMismatch},
+		{"Falha: chave Ed25519 para token ES256", KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+			return edPub, nil
+		}), ErrAlgorithmMismatch},
+		{"Falha: chave P-256 diferente", ECDSAKeyResolverFunc(func(ctx context.Context, kid string) (*ecdsa.PublicKey, error) {
+			other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			return &other.PublicKey, nil
+		}), ErrInvalidSignature},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseWithResolver(context.Background(), tokenBytes, tc.resolver)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	p521, _ := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if _, err := NewPayload().SignECDSA(p521); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("esperava ErrUnsupportedAlgorithm para P-521, obteve: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// TokenPrefix é o prefixo versionado da codificação textual de tokens Signet.
+const TokenPrefix = "sgn1."
+
+// tokenEncoding é o base64url sem padding, rejeitando bits de preenchimento diferentes de zero.
+var tokenEncoding = base64.RawURLEncoding.Strict()
+
+// EncodeToken converte um token serializado para a forma textual TokenPrefix || base64url(token),
+// sem padding, segura para headers HTTP, cookies e query strings.
+//
+// Exemplo:
+//
+//	req.Header.Set("Authorization", "Bearer "+signet.EncodeToken(tokenBytes))
+func EncodeToken(tokenBytes []byte) string {
+	return TokenPrefix + tokenEncoding.EncodeToString(tokenBytes)
+}
+
+// DecodeToken converte a forma textual produzida por EncodeToken de volta para os bytes do token.
+// A decodificação é estrita: exige o prefixo TokenPrefix e rejeitam-se padding, quebras de linha,
+// o alfabeto base64 padrão e bits de preenchimento diferentes de zero, de modo que cada token
+// possui uma única representação textual. Falhas retornam ErrInvalidTokenEncoding.
+//
+// Exemplo:
+//
+//	tokenBytes, err := signet.DecodeToken(strings.TrimPrefix(header, "Bearer "))
+func DecodeToken(encoded string) ([]byte, error) {
+	data, ok := strings.CutPrefix(encoded, TokenPrefix)
+	if !ok {
+		return nil, fmt.Errorf("prefixo '%s' ausente: %w", TokenPrefix, ErrInvalidTokenEncoding)
+	}
+	// O decodificador ignora '\r' e '\n'; rejeitá-los mantém a codificação canônica
+	if strings.ContainsAny(data, "\r\n") {
+		return nil, fmt.Errorf("quebra de linha no token: %w", ErrInvalidTokenEncoding)
+	}
+	tokenBytes, err := tokenEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("base64url inválido: %w", ErrInvalidTokenEncoding)
+	}
+	return tokenBytes, nil
+}
+
+// SignString assina o payload com Ed25519 e retorna o token na forma textual de EncodeToken.
+//
+// Exemplo:
+//
+//	token, err := signet.NewPayload().WithSubject("user-123").SignString(privateKey)
+//	http.SetCookie(w, &http.Cookie{Name: "session", Value: token, HttpOnly: true, Secure: true})
+func (b *PayloadBuilder) SignString(privateKey ed25519.PrivateKey) (string, error) {
+	tokenBytes, err := b.Sign(privateKey)
+	if err != nil {
+		return "", err
+	}
+	return EncodeToken(tokenBytes), nil
+}
+
+// ParseString decodifica um token textual com DecodeToken e o valida como Parse.
+// Tokens com codificação inválida são rejeitados com ErrInvalidTokenEncoding.
+//
+// Exemplo:
+//
+//	cookie, _ := r.Cookie("session")
+//	payload, err := signet.ParseString(ctx, cookie.Value, keyResolver, signet.WithAudience("web"))
+func ParseString(ctx context.Context, token string, keyResolver KeyResolverFunc, options ...ValidationOption) (*signetv1.SignetPayload, error) {
+	return ParseStringWithResolver(ctx, token, keyResolver, options...)
+}
+
+// ParseStringWithResolver é equivalente a ParseString, mas resolve a chave através de um
+// KeyResolver, como ParseWithResolver, permitindo tokens ECDSA, ML-DSA, híbridos ou com
+// chaves por emissor.
+//
+// Exemplo:
+//
+//	payload, err := signet.ParseStringWithResolver(ctx, header, resolver, signet.WithAudience("web"))
+func ParseStringWithResolver(ctx context.Context, token string, keyResolver KeyResolver, options ...ValidationOption) (*signetv1.SignetPayload, error) {
+	config := newValidationConfig(options)
+	tokenBytes, err := DecodeToken(token)
+	if err != nil {
+		return nil, config.fail(ctx, ReasonInvalidTokenEncoding, nil, err)
+	}
+	return parseToken(ctx, tokenBytes, keyResolver, config)
+}
@@ -0,0 +1,108 @@
+package signet
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Testa o round-trip da forma textual e a rejeição de codificações não canônicas
+func TestEncodeDecodeToken(t *testing.T) {
+	tokenBytes := []byte{0xfb, 0xff, 0x01, 0x02, 0x03}
+	encoded := EncodeToken(tokenBytes)
+	if encoded != "sgn1.-_8BAgM" {
+		t.Fatalf("codificação inesperada: %q", encoded)
+	}
+	decoded, err := DecodeToken(encoded)
+	if err != nil || !bytes.Equal(decoded, tokenBytes) {
+		t.Fatalf("round-trip falhou: %x, %v", decoded, err)
+	}
+
+	testCases := []struct {
+		name    string
+		encoded string
+	}{
+		{"Falha: sem prefixo", "-_8BAgM"},
+		{"Falha: prefixo de outra versão", "sgn2.-_8BAgM"},
+		{"Falha: com padding", "sgn1.-_8BAgM="},
+		{"Falha: alfabeto base64 padrão", "sgn1." + base64.RawStdEncoding.EncodeToString(tokenBytes)},
+		{"Falha: bits de preenchimento não nulos", "sgn1.-_8BAgN"},
+		{"Falha: quebra de linha", "sgn1.-_8B\nAgM"},
+		{"Falha: espaço", "sgn1.-_8B AgM"},
+		{"Falha: tamanho impossível", "sgn1.-_8BA"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := DecodeToken(tc.encoded); !errors.Is(err, ErrInvalidTokenEncoding) {
+				t.Errorf("esperava ErrInvalidTokenEncoding para %q, obteve: %v", tc.encoded, err)
+			}
+		})
+	}
+}
+
+// Testa SignString e ParseString de ponta a ponta
+func TestSignString_ParseString(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	token, err := NewPayload().WithSubject("user-123").WithAudience("web").SignString(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	if !strings.HasPrefix(token, TokenPrefix) || strings.ContainsAny(token, "+/=") {
+		t.Fatalf("token textual não é seguro para URLs: %q", token)
+	}
+
+	recorder := &countingRecorder{}
+	testCases := []struct {
+		name          string
+		token         string
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: token textual válido", token, []ValidationOption{WithAudience("web")}, nil},
+		{"Falha: codificação inválida", token + "=", []ValidationOption{WithMetricsRecorder(recorder)}, ErrInvalidTokenEncoding},
+		{"Falha: validações do Parse continuam ativas", token, []ValidationOption{WithAudience("outra")}, ErrAudienceMismatch},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := ParseString(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+			if err == nil && payload.Sub != "user-123" {
+				t.Errorf("sub esperado 'user-123', obteve %q", payload.Sub)
+			}
+		})
+	}
+	if recorder.reasons[ReasonInvalidTokenEncoding] != 1 {
+		t.Errorf("esperava 1 métrica %q, obteve %v", ReasonInvalidTokenEncoding, recorder.reasons)
+	}
+}
+
+// Testa ParseStringWithResolver com chaves de outros algoritmos
+func TestParseStringWithResolver(t *testing.T) {
+	priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		return VerificationKey{Algorithm: AlgES256, Key: &priv.PublicKey}, nil
+	})
+	tokenBytes, err := NewPayload().WithSubject("user-123").SignWithAlgorithm(AlgES256, priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	payload, err := ParseStringWithResolver(context.Background(), EncodeToken(tokenBytes), resolver)
+	if err != nil || payload.Sub != "user-123" {
+		t.Fatalf("token ES256 textual deveria ser aceito, obteve %v, %v", payload, err)
+	}
+	if _, err := ParseStringWithResolver(context.Background(), "sem-prefixo", resolver); !errors.Is(err, ErrInvalidTokenEncoding) {
+		t.Errorf("esperava ErrInvalidTokenEncoding, obteve: %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package signet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// CheckResult é o resultado de uma validação no relatório do Explain.
+type CheckResult struct {
+	// Name identifica a validação: "token" (formato, versão e codificação), "iss",
+	// "signature", "exp", "iat", "nbf", "max_lifetime", "max_age", "aud", "roles",
+	// "sid" ou "extension".
+	Name string
+	// Passed indica se a validação foi bem-sucedida.
+	Passed bool
+	// Reason é a razão de métrica da falha, ou vazio se Passed.
+	Reason string
+	// Values descreve os valores relevantes (ex: "exp=... agora=... tolerância=...").
+	Values string
+	// Err é o erro da falha, ou nil se Passed.
+	Err error
+}
+
+// ExplainReport é o relatório produzido por Explain.
+type ExplainReport struct {
+	// Payload é o payload deserializado, ou nil se o token não pôde ser deserializado.
+	// Se SignatureVerified for false, o conteúdo NÃO é confiável.
+	Payload *signetv1.SignetPayload
+	// SignatureVerified indica se a(s) assinatura(s) foram verificadas com sucesso.
+	SignatureVerified bool
+	// Checks lista as validações executadas, na ordem do Parse.
+	Checks []CheckResult
+}
+
+// Valid indica se o token seria aceito pelo Parse, desconsiderando a proteção contra replay.
+func (r *ExplainReport) Valid() bool {
+	return r.SignatureVerified && len(r.Failures()) == 0
+}
+
+// Failures retorna apenas as validações que falharam.
+func (r *ExplainReport) Failures() []CheckResult {
+	var failures []CheckResult
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failures = append(failures, check)
+		}
+	}
+	return failures
+}
+
+// String formata o relatório com uma linha por validação.
+func (r *ExplainReport) String() string {
+	var sb strings.Builder
+	for _, check := range r.Checks {
+		status := "OK"
+		if !check.Passed {
+			status = "FALHA (" + check.Reason + ")"
+		}
+		fmt.Fprintf(&sb, "%-12s %s", check.Name, status)
+		if check.Values != "" {
+			fmt.Fprintf(&sb, ": %s", check.Values)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// Explain diagnostica um token, destinado a ferramentas de suporte: ao contrário do Parse,
+// que para na primeira falha, executa TODAS as validações de claims configuradas após a
+// verificação da assinatura e retorna um relatório com o resultado e os valores de cada uma.
+// Falhas de formato, de emissor (WithIssuer) ou de assinatura interrompem o relatório, pois
+// os claims de um token não verificado não são confiáveis.
+//
+// Explain não registra métricas e não executa a proteção contra replay, que consumiria o jti.
+// Não use Explain para autorizar requisições; use Parse ou Validator.Validate.
+//
+// Exemplo:
+//
+//	report := signet.Explain(ctx, tokenBytes, resolver,
+//	    signet.WithAudience("api-backend"), signet.RequireRole("admin"))
+//	if !report.Valid() {
+//	    log.Printf("token rejeitado:\n%s", report)
+//	}
+func Explain(ctx context.Context, tokenBytes []byte, keyResolver KeyResolver, options ...ValidationOption) *ExplainReport {
+	config := newValidationConfig(options)
+	config.metricsRecorder = nil
+	report := &ExplainReport{}
+
+	payload, reason, err := decodeAndVerify(ctx, tokenBytes, keyResolver, config)
+	report.Payload = payload
+	failedStage := ""
+	if err != nil {
+		failedStage = verificationStage(reason)
+	}
+	for _, stage := range []string{"token", "iss", "signature"} {
+		if stage == "iss" && config.allowedIssuers == nil {
+			continue
+		}
+		if stage == failedStage {
+			report.Checks = append(report.Checks, CheckResult{Name: stage, Reason: reason, Values: stageValues(stage, payload, config), Err: err})
+			return report
+		}
+		report.Checks = append(report.Checks, CheckResult{Name: stage, Passed: true, Values: stageValues(stage, payload, config)})
+	}
+	report.SignatureVerified = true
+
+	for _, check := range claimChecks(payload, config, config.now()) {
+		result := CheckResult{Name: check.name, Passed: true, Values: check.values()}
+		if reason, err := check.run(); err != nil {
+			result.Passed, result.Reason, result.Err = false, reason, err
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+// verificationStage identifica a etapa anterior às validações de claims em que a falha ocorreu.
+func verificationStage(reason string) string {
+	switch reason {
+	case ReasonInvalidPayload, ReasonUnsupportedVersion, ReasonNonCanonicalEncoding:
+		return "token"
+	case ReasonIssuerMismatch:
+		return "iss"
+	default:
+		return "signature"
+	}
+}
+
+// stageValues descreve os valores relevantes das etapas de formato, emissor e assinatura.
+func stageValues(stage string, payload *signetv1.SignetPayload, config *validationConfig) string {
+	if payload == nil {
+		return ""
+	}
+	switch stage {
+	case "iss":
+		issuers := make([]string, 0, len(config.allowedIssuers))
+		for iss := range config.allowedIssuers {
+			issuers = append(issuers, iss)
+		}
+		sort.Strings(issuers)
+		return fmt.Sprintf("aceitos=%q token=%q", issuers, payload.Iss)
+	case "signature":
+		return fmt.Sprintf("kid=%q", payload.Kid)
+	}
+	return ""
+}
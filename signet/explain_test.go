@@ -0,0 +1,122 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucas-de-lima/signet-go/signet/signettest"
+)
+
+// Testa que Explain reporta todas as falhas de claims, sem parar na primeira
+func TestExplain_CollectsAllFailures(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	start := time.Unix(1700000000, 0)
+	tokenBytes, _ := NewPayload().
+		WithIssuedAt(start.Unix()).
+		WithExpiration(start.Unix() + 60).
+		WithAudience("web").
+		WithRole("user").
+		WithKeyID("v1").
+		Sign(priv)
+	clock := signettest.NewFakeClock(start.Add(time.Hour))
+	recorder := &countingRecorder{}
+	store := NewMemoryReplayStore()
+	options := []ValidationOption{
+		WithClock(clock), WithAudience("api"), RequireRoles("user", "admin"),
+		WithMetricsRecorder(recorder), WithReplayProtection(store),
+	}
+
+	report := Explain(context.Background(), tokenBytes, resolver, options...)
+	if !report.SignatureVerified || report.Valid() || report.Payload == nil {
+		t.Fatalf("relatório inesperado: %+v", report)
+	}
+	expected := map[string]error{
+		"token":     nil,
+		"signature": nil,
+		"exp":       ErrTokenExpired,
+		"iat":       nil,
+		"nbf":       nil,
+		"aud":       ErrAudienceMismatch,
+		"roles":     ErrMissingRequiredRole,
+	}
+	if len(report.Checks) != len(expected) {
+		t.Fatalf("esperava %d validações, obteve %d:\n%s", len(expected), len(report.Checks), report)
+	}
+	for _, check := range report.Checks {
+		want, ok := expected[check.Name]
+		if !ok {
+			t.Errorf("validação inesperada %q", check.Name)
+			continue
+		}
+		if check.Passed != (want == nil) || !errors.Is(check.Err, want) {
+			t.Errorf("%s: esperava erro '%v', obteve passed=%v err='%v'", check.Name, want, check.Passed, check.Err)
+		}
+	}
+	if len(report.Failures()) != 3 {
+		t.Errorf("esperava 3 falhas, obteve %d", len(report.Failures()))
+	}
+	text := report.String()
+	for _, fragment := range []string{"FALHA (token_expired)", `ausentes=["admin"]`, `esperada="api"`} {
+		if !strings.Contains(text, fragment) {
+			t.Errorf("relatório sem %q:\n%s", fragment, text)
+		}
+	}
+
+	// Explain não registra métricas nem consome o jti
+	if len(recorder.reasons) != 0 || store.Len() != 0 {
+		t.Errorf("Explain teve efeitos colaterais: métricas=%v jtis=%d", recorder.reasons, store.Len())
+	}
+	// Parse continua parando na primeira falha
+	if _, err := Parse(context.Background(), tokenBytes, resolver, options...); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("esperava ErrTokenExpired do Parse, obteve: %v", err)
+	}
+}
+
+// Testa que falhas anteriores às validações de claims interrompem o relatório
+func TestExplain_StopsBeforeClaims(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	_, otherKey, _ := ed25519.GenerateKey(nil)
+	forged, _ := NewPayload().WithIssuer("auth").Sign(otherKey)
+	valid, _ := NewPayload().WithIssuer("auth").Sign(priv)
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedLast  string
+		expectedError error
+	}{
+		{"Token corrompido", []byte{0xFF, 0xFF}, nil, "token", ErrInvalidPayload},
+		{"Emissor não aceito", valid, []ValidationOption{WithIssuer("outro")}, "iss", ErrIssuerMismatch},
+		{"Assinatura inválida", forged, []ValidationOption{WithIssuer("auth")}, "signature", ErrInvalidSignature},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := Explain(context.Background(), tc.token, resolver, tc.options...)
+			if report.SignatureVerified || report.Valid() {
+				t.Fatalf("relatório não deveria ser válido:\n%s", report)
+			}
+			last := report.Checks[len(report.Checks)-1]
+			if last.Name != tc.expectedLast || last.Passed || !errors.Is(last.Err, tc.expectedError) {
+				t.Errorf("esperava falha em %q com '%v', obteve %+v", tc.expectedLast, tc.expectedError, last)
+			}
+			if len(report.Failures()) != 1 {
+				t.Errorf("esperava apenas 1 falha, obteve %d", len(report.Failures()))
+			}
+		})
+	}
+
+	if report := Explain(context.Background(), valid, resolver, WithIssuer("auth")); !report.Valid() {
+		t.Errorf("token válido reportado como inválido:\n%s", report)
+	}
+}
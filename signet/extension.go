@@ -0,0 +1,66 @@
+package signet
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// WithExtension anexa ao payload uma mensagem protobuf da aplicação com seus claims de domínio,
+// empacotada como google.protobuf.Any. Erros de serialização são retornados por Build/Sign.
+//
+// Exemplo:
+//
+//	tokenBytes, err := signet.NewPayload().
+//	    WithSubject("user-123").
+//	    WithExtension(&pedidospb.Claims{LimiteCompra: 5000}).
+//	    Sign(privateKey)
+func (b *PayloadBuilder) WithExtension(msg proto.Message) *PayloadBuilder {
+	ext, err := anypb.New(msg)
+	if err != nil {
+		b.err = fmt.Errorf("falha ao serializar extensão: %w", err)
+		return b
+	}
+	b.payload.Extension = ext
+	return b
+}
+
+// ParseWithClaims valida o token como ParseWithResolver e deserializa a extensão do payload
+// na mensagem de claims da aplicação T. Tokens sem extensão, ou cuja extensão tenha outro
+// type URL, são rejeitados com ErrExtensionMismatch.
+//
+// Exemplo:
+//
+//	payload, claims, err := signet.ParseWithClaims[*pedidospb.Claims](ctx, tokenBytes, resolver)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Println(payload.Sub, claims.LimiteCompra)
+func ParseWithClaims[T proto.Message](ctx context.Context, tokenBytes []byte, keyResolver KeyResolver, options ...ValidationOption) (*signetv1.SignetPayload, T, error) {
+	var zero T
+	claims := zero.ProtoReflect().Type().New().Interface().(T)
+	options = append(options[:len(options):len(options)], func(c *validationConfig) {
+		c.extension = claims
+	})
+	payload, err := ParseWithResolver(ctx, tokenBytes, keyResolver, options...)
+	if err != nil {
+		return nil, zero, err
+	}
+	return payload, claims, nil
+}
+
+// unmarshalExtension verifica o type URL da extensão e a deserializa em target.
+func unmarshalExtension(payload *signetv1.SignetPayload, target proto.Message) (string, error) {
+	if payload.Extension == nil || !payload.Extension.MessageIs(target) {
+		return ReasonExtensionMismatch, fmt.Errorf("esperava '%s', obteve '%s': %w",
+			target.ProtoReflect().Descriptor().FullName(), payload.Extension.GetTypeUrl(), ErrExtensionMismatch)
+	}
+	if err := payload.Extension.UnmarshalTo(target); err != nil {
+		return ReasonInvalidPayload, fmt.Errorf("falha ao deserializar extensão: %w", ErrInvalidPayload)
+	}
+	return "", nil
+}
@@ -0,0 +1,88 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Testa o round-trip de claims da aplicação via extensão e a rejeição de tipos diferentes
+func TestParseWithClaims(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	domainClaims, _ := structpb.NewStruct(map[string]any{"limite_compra": 5000, "loja": "centro"})
+	tokenBytes, err := NewPayload().WithSubject("user-123").WithExtension(domainClaims).Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	noExtension, _ := NewPayload().Sign(priv)
+
+	payload, claims, err := ParseWithClaims[*structpb.Struct](context.Background(), tokenBytes, resolver)
+	if err != nil {
+		t.Fatalf("erro ao validar: %v", err)
+	}
+	if payload.Sub != "user-123" {
+		t.Errorf("sub esperado 'user-123', obteve %q", payload.Sub)
+	}
+	if claims.Fields["loja"].GetStringValue() != "centro" || claims.Fields["limite_compra"].GetNumberValue() != 5000 {
+		t.Errorf("claims da extensão inesperados: %v", claims)
+	}
+
+	recorder := &countingRecorder{}
+	testCases := []struct {
+		name          string
+		parse         func() error
+		expectedError error
+	}{
+		{"Falha: type URL diferente", func() error {
+			_, _, err := ParseWithClaims[*durationpb.Duration](context.Background(), tokenBytes, resolver, WithMetricsRecorder(recorder))
+			return err
+		}, ErrExtensionMismatch},
+		{"Falha: extensão ausente", func() error {
+			_, _, err := ParseWithClaims[*structpb.Struct](context.Background(), noExtension, resolver, WithMetricsRecorder(recorder))
+			return err
+		}, ErrExtensionMismatch},
+		{"Falha: demais validações continuam ativas", func() error {
+			_, _, err := ParseWithClaims[*structpb.Struct](context.Background(), tokenBytes, resolver, WithAudience("outra"))
+			return err
+		}, ErrAudienceMismatch},
+		{"Sucesso: ParseWithResolver ignora a extensão", func() error {
+			_, err := ParseWithResolver(context.Background(), noExtension, resolver)
+			return err
+		}, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.parse(); !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+	if recorder.reasons[ReasonExtensionMismatch] != 2 || recorder.reasons[ReasonSuccess] != 0 {
+		t.Errorf("métricas inesperadas: %v", recorder.reasons)
+	}
+}
+
+// Testa a rejeição de extensões corrompidas
+func TestParseWithClaims_CorruptedExtension(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	builder := NewPayload().WithExtension(durationpb.New(5))
+	builder.payload.Extension.Value = []byte{0xFF}
+	tokenBytes, err := builder.Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	_, _, err = ParseWithClaims[*durationpb.Duration](context.Background(), tokenBytes, resolver)
+	if !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("esperava ErrInvalidPayload, obteve: %v", err)
+	}
+}
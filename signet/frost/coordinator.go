@@ -0,0 +1,204 @@
+package frost
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"filippo.io/edwards25519"
+)
+
+// Coordinator conduz as duas rodadas do protocolo com os participantes e agrega as partes
+// em uma assinatura Ed25519 comum. Não mantém nenhum segredo.
+//
+// Implementa crypto.Signer e signet.ContextSigner: o emissor assina tokens com
+// PayloadBuilder.SignWith, e os verificadores usam apenas a chave pública do grupo.
+type Coordinator struct {
+	publicKeys   *PublicKeyPackage
+	participants []Participant
+}
+
+// NewCoordinator cria um coordenador para o grupo descrito por publicKeys.
+// Pelo menos Threshold participantes devem ser fornecidos, em ordem de preferência: a cada
+// assinatura, os primeiros Threshold participantes disponíveis são selecionados.
+func NewCoordinator(publicKeys *PublicKeyPackage, participants ...Participant) (*Coordinator, error) {
+	if publicKeys == nil {
+		return nil, ErrInvalidKeyShare
+	}
+	if err := validateThreshold(publicKeys.Threshold, len(publicKeys.VerifyingShares)); err != nil {
+		return nil, err
+	}
+	if _, err := decodeElement(publicKeys.GroupPublicKey); err != nil {
+		return nil, ErrInvalidKeyShare
+	}
+	if len(participants) < publicKeys.Threshold {
+		return nil, fmt.Errorf("%d participantes para limiar %d: %w", len(participants), publicKeys.Threshold, ErrInsufficientParticipants)
+	}
+	seen := make(map[uint16]bool, len(participants))
+	for _, p := range participants {
+		if p == nil || seen[p.Identifier()] {
+			return nil, ErrInvalidIdentifier
+		}
+		if _, ok := publicKeys.VerifyingShares[p.Identifier()]; !ok {
+			return nil, fmt.Errorf("participante %d fora do grupo: %w", p.Identifier(), ErrInvalidIdentifier)
+		}
+		seen[p.Identifier()] = true
+	}
+	return &Coordinator{publicKeys: publicKeys, participants: participants}, nil
+}
+
+// Public implementa crypto.Signer, retornando a chave pública Ed25519 do grupo.
+func (c *Coordinator) Public() crypto.PublicKey {
+	return c.publicKeys.GroupPublicKey
+}
+
+// Sign implementa crypto.Signer. Equivale a SignContext com context.Background().
+func (c *Coordinator) Sign(random io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return c.SignContext(context.Background(), random, message, opts)
+}
+
+// SignContext executa o protocolo de assinatura sobre a mensagem completa (Ed25519 puro,
+// opts.HashFunc() == 0). O parâmetro random não é usado: os nonces são gerados pelos participantes.
+func (c *Coordinator) SignContext(ctx context.Context, _ io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts != nil && opts.HashFunc() != crypto.Hash(0) {
+		return nil, ErrUnsupportedSignerOpts
+	}
+	// 1. Primeira rodada: solicitar compromissos a t participantes, recorrendo aos
+	// seguintes quando algum falhar, para não deixar nonces pendentes sem necessidade
+	var selected []Participant
+	var commitments []*Commitment
+	var commitErrs []error
+	remaining := c.participants
+	for len(selected) < c.publicKeys.Threshold && len(remaining) > 0 {
+		batch := remaining[:min(c.publicKeys.Threshold-len(selected), len(remaining))]
+		remaining = remaining[len(batch):]
+		results := make([]*Commitment, len(batch))
+		errs := make([]error, len(batch))
+		var wg sync.WaitGroup
+		for i, p := range batch {
+			wg.Add(1)
+			go func(i int, p Participant) {
+				defer wg.Done()
+				results[i], errs[i] = p.Commit(ctx)
+			}(i, p)
+		}
+		wg.Wait()
+		for i, p := range batch {
+			if errs[i] == nil && (results[i] == nil || results[i].Identifier != p.Identifier()) {
+				errs[i] = ErrInvalidCommitment
+			}
+			if errs[i] != nil {
+				commitErrs = append(commitErrs, fmt.Errorf("participante %d: %w", p.Identifier(), errs[i]))
+				continue
+			}
+			selected = append(selected, p)
+			commitments = append(commitments, results[i])
+		}
+	}
+	if len(selected) < c.publicKeys.Threshold {
+		discardCommitments(ctx, selected, commitments)
+		return nil, fmt.Errorf("%w: %w", ErrInsufficientParticipants, errors.Join(commitErrs...))
+	}
+
+	// 2. Segunda rodada: coletar as partes de assinatura dos selecionados
+	shares := make([]*SignatureShare, len(selected))
+	errs := make([]error, len(selected))
+	var wg sync.WaitGroup
+	for i, p := range selected {
+		wg.Add(1)
+		go func(i int, p Participant) {
+			defer wg.Done()
+			shares[i], errs[i] = p.SignShare(ctx, message, commitments)
+		}(i, p)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			discardCommitments(ctx, selected, commitments)
+			return nil, fmt.Errorf("participante %d: %w", selected[i].Identifier(), err)
+		}
+	}
+
+	// 3. Agregar e conferir a assinatura final
+	return Aggregate(c.publicKeys, message, commitments, shares)
+}
+
+// discardCommitments pede a cada participante que descarte os nonces de seu compromisso em uma
+// assinatura abandonada. É feito com o melhor esforço, mesmo após o cancelamento de ctx, e
+// inofensivo para quem já os consumiu na segunda rodada.
+func discardCommitments(ctx context.Context, participants []Participant, commitments []*Commitment) {
+	ctx = context.WithoutCancel(ctx)
+	var wg sync.WaitGroup
+	for i, p := range participants {
+		wg.Add(1)
+		go func(p Participant, commitment *Commitment) {
+			defer wg.Done()
+			_ = p.Discard(ctx, commitment)
+		}(p, commitments[i])
+	}
+	wg.Wait()
+}
+
+// Aggregate verifica cada parte de assinatura contra a parte pública do participante e as
+// combina na assinatura Ed25519 final (R || z). Permite implementar coordenadores fora do
+// processo, que trocam compromissos e partes com os participantes pela rede.
+func Aggregate(publicKeys *PublicKeyPackage, message []byte, commitments []*Commitment, shares []*SignatureShare) ([]byte, error) {
+	groupKey, err := decodeElement(publicKeys.GroupPublicKey)
+	if err != nil {
+		return nil, ErrInvalidKeyShare
+	}
+	sp, err := newSigningPackage(groupKey, publicKeys.Threshold, message, commitments)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) != len(sp.ids) {
+		return nil, fmt.Errorf("%d partes para %d compromissos: %w", len(shares), len(sp.ids), ErrInvalidSignatureShare)
+	}
+	z := edwards25519.NewScalar()
+	seen := make(map[uint16]bool, len(shares))
+	for _, share := range shares {
+		if share == nil || seen[share.Identifier] {
+			return nil, ErrInvalidSignatureShare
+		}
+		seen[share.Identifier] = true
+		if err := verifySignatureShare(publicKeys, sp, share); err != nil {
+			return nil, fmt.Errorf("participante %d: %w", share.Identifier, err)
+		}
+		zi, _ := decodeScalar(share.Share)
+		z.Add(z, zi)
+	}
+	signature := append(sp.groupCommitment.Bytes(), z.Bytes()...)
+	if !ed25519.Verify(publicKeys.GroupPublicKey, message, signature) {
+		return nil, ErrInvalidSignatureShare
+	}
+	return signature, nil
+}
+
+// verifySignatureShare verifica G·z_i == D_i + ρ_i·E_i + (c·λ_i)·Y_i (RFC 9591, seção 5.4),
+// identificando participantes que enviaram partes inválidas.
+func verifySignatureShare(publicKeys *PublicKeyPackage, sp *signingPackage, share *SignatureShare) error {
+	hiding, ok := sp.hiding[share.Identifier]
+	if !ok {
+		return ErrInvalidSignatureShare
+	}
+	verifyingShare, err := decodeElement(publicKeys.VerifyingShares[share.Identifier])
+	if err != nil {
+		return ErrInvalidKeyShare
+	}
+	z, err := decodeScalar(share.Share)
+	if err != nil {
+		return ErrInvalidSignatureShare
+	}
+	lambda := lagrangeCoefficient(share.Identifier, sp.ids)
+	expected := new(edwards25519.Point).ScalarMult(sp.bindingFactors[share.Identifier], sp.binding[share.Identifier])
+	expected.Add(expected, hiding)
+	expected.Add(expected, new(edwards25519.Point).ScalarMult(edwards25519.NewScalar().Multiply(sp.challenge, lambda), verifyingShare))
+	if new(edwards25519.Point).ScalarBaseMult(z).Equal(expected) != 1 {
+		return ErrInvalidSignatureShare
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package frost
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/lucas-de-lima/signet-go/signet"
+)
+
+// offlineParticipant simula um nó indisponível na primeira rodada.
+type offlineParticipant struct{ Participant }
+
+func (offlineParticipant) Commit(ctx context.Context) (*Commitment, error) {
+	return nil, errors.New("nó indisponível")
+}
+
+// failingParticipant simula um nó que falha na segunda rodada sem consumir seus nonces.
+type failingParticipant struct{ Participant }
+
+func (failingParticipant) SignShare(ctx context.Context, message []byte, commitments []*Commitment) (*SignatureShare, error) {
+	return nil, errors.New("nó indisponível")
+}
+
+// newTestCoordinator cria um coordenador com participantes locais para todas as partes.
+func newTestCoordinator(t *testing.T, shares []*KeyShare, publicKeys *PublicKeyPackage) *Coordinator {
+	t.Helper()
+	var participants []Participant
+	for _, p := range newLocalParticipants(t, shares) {
+		participants = append(participants, p)
+	}
+	coordinator, err := NewCoordinator(publicKeys, participants...)
+	if err != nil {
+		t.Fatalf("erro ao criar coordenador: %v", err)
+	}
+	return coordinator
+}
+
+// Testa que tokens assinados por limiar são aceitos pelo Parse sem alterações no verificador
+func TestCoordinator_SignWithAndParse(t *testing.T) {
+	ctx := context.Background()
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	shares, publicKeys, err := SplitKey(nil, priv, 2, 3)
+	if err != nil {
+		t.Fatalf("erro ao dividir chave: %v", err)
+	}
+	coordinator := newTestCoordinator(t, shares, publicKeys)
+
+	tokenBytes, err := signet.NewPayload().WithKeyID("frost-v1").WithSubject("user-123").SignWith(ctx, coordinator)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		if kid != "frost-v1" {
+			return nil, signet.ErrUnknownKeyID
+		}
+		return pub, nil
+	}
+	payload, err := signet.Parse(ctx, tokenBytes, keyResolver)
+	if err != nil {
+		t.Fatalf("token assinado por limiar deveria ser aceito: %v", err)
+	}
+	if payload.Sub != "user-123" {
+		t.Errorf("sub esperado 'user-123', obteve %q", payload.Sub)
+	}
+}
+
+// Testa a seleção de participantes disponíveis e as falhas do coordenador
+func TestCoordinator_Availability(t *testing.T) {
+	ctx := context.Background()
+	shares, publicKeys, _ := GenerateKeyShares(nil, 2, 3)
+	locals := newLocalParticipants(t, shares)
+	message := []byte("mensagem")
+
+	// Um nó indisponível é substituído pelo seguinte
+	coordinator, err := NewCoordinator(publicKeys, offlineParticipant{locals[0]}, locals[1], locals[2])
+	if err != nil {
+		t.Fatalf("erro ao criar coordenador: %v", err)
+	}
+	signature, err := coordinator.SignContext(ctx, nil, message, crypto.Hash(0))
+	if err != nil {
+		t.Fatalf("erro ao assinar com um nó indisponível: %v", err)
+	}
+	if !ed25519.Verify(publicKeys.GroupPublicKey, message, signature) {
+		t.Error("assinatura inválida")
+	}
+
+	// Menos de t nós disponíveis
+	coordinator, _ = NewCoordinator(publicKeys, offlineParticipant{locals[0]}, offlineParticipant{locals[1]}, locals[2])
+	if _, err := coordinator.Sign(nil, message, crypto.Hash(0)); !errors.Is(err, ErrInsufficientParticipants) {
+		t.Errorf("esperava ErrInsufficientParticipants, obteve: %v", err)
+	}
+
+	// Pré-hash não é suportado
+	if _, err := coordinator.Sign(nil, message, crypto.SHA256); !errors.Is(err, ErrUnsupportedSignerOpts) {
+		t.Errorf("esperava ErrUnsupportedSignerOpts, obteve: %v", err)
+	}
+
+	// Contexto cancelado
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	coordinator, _ = NewCoordinator(publicKeys, locals[0], locals[1])
+	if _, err := coordinator.SignContext(canceled, nil, message, crypto.Hash(0)); !errors.Is(err, context.Canceled) {
+		t.Errorf("esperava context.Canceled, obteve: %v", err)
+	}
+
+	// Configurações inválidas
+	if _, err := NewCoordinator(publicKeys, locals[0]); !errors.Is(err, ErrInsufficientParticipants) {
+		t.Errorf("esperava ErrInsufficientParticipants, obteve: %v", err)
+	}
+	if _, err := NewCoordinator(publicKeys, locals[0], locals[0]); !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("esperava ErrInvalidIdentifier, obteve: %v", err)
+	}
+}
+
+// Testa que o coordenador descarta os nonces pendentes das assinaturas abandonadas
+func TestCoordinator_DiscardsAbandonedNonces(t *testing.T) {
+	shares, publicKeys, _ := GenerateKeyShares(nil, 2, 3)
+	locals := newLocalParticipants(t, shares)
+	message := []byte("mensagem")
+
+	testCases := []struct {
+		name         string
+		participants []Participant
+	}{
+		{"Falha na primeira rodada", []Participant{offlineParticipant{locals[0]}, offlineParticipant{locals[1]}, locals[2]}},
+		{"Falha na segunda rodada", []Participant{locals[0], failingParticipant{locals[1]}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coordinator, err := NewCoordinator(publicKeys, tc.participants...)
+			if err != nil {
+				t.Fatalf("erro ao criar coordenador: %v", err)
+			}
+			if _, err := coordinator.Sign(nil, message, crypto.Hash(0)); err == nil {
+				t.Fatal("esperava falha na assinatura")
+			}
+			for _, p := range locals {
+				if pending := len(p.pending); pending != 0 {
+					t.Errorf("participante %d manteve %d compromissos pendentes", p.Identifier(), pending)
+				}
+			}
+		})
+	}
+}
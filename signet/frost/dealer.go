@@ -0,0 +1,86 @@
+package frost
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"io"
+
+	"filippo.io/edwards25519"
+)
+
+// GenerateKeyShares gera uma nova chave de grupo por meio de um distribuidor confiável e a
+// divide em n partes, das quais quaisquer threshold reconstroem a capacidade de assinar
+// (RFC 9591, apêndice C). A chave completa existe apenas durante a execução desta função.
+// Se random for nil, crypto/rand.Reader é usado.
+func GenerateKeyShares(random io.Reader, threshold, n int) ([]*KeyShare, *PublicKeyPackage, error) {
+	if err := validateThreshold(threshold, n); err != nil {
+		return nil, nil, err
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+	secret, err := randomScalar(random)
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitSecret(random, secret, threshold, n)
+}
+
+// SplitKey divide uma chave Ed25519 existente em n partes, preservando a chave pública já
+// publicada aos verificadores. Permite migrar um emissor para assinatura por limiar sem
+// rotacionar a chave. A chave original deve ser destruída após a distribuição das partes.
+// Se random for nil, crypto/rand.Reader é usado.
+func SplitKey(random io.Reader, privateKey ed25519.PrivateKey, threshold, n int) ([]*KeyShare, *PublicKeyPackage, error) {
+	if err := validateThreshold(threshold, n); err != nil {
+		return nil, nil, err
+	}
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, nil, ErrInvalidKeyShare
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+	// Escalar secreto do Ed25519: SHA-512(seed)[:32] com clamping (RFC 8032, seção 5.1.5)
+	h := sha512.Sum512(privateKey.Seed())
+	secret, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitSecret(random, secret, threshold, n)
+}
+
+// splitSecret divide o segredo via Shamir: f(x) = secret + a_1·x + ... + a_{t-1}·x^{t-1},
+// com a parte do participante i igual a f(i).
+func splitSecret(random io.Reader, secret *edwards25519.Scalar, threshold, n int) ([]*KeyShare, *PublicKeyPackage, error) {
+	coefficients := make([]*edwards25519.Scalar, threshold)
+	coefficients[0] = secret
+	for i := 1; i < threshold; i++ {
+		c, err := randomScalar(random)
+		if err != nil {
+			return nil, nil, err
+		}
+		coefficients[i] = c
+	}
+	groupPublicKey := new(edwards25519.Point).ScalarBaseMult(secret).Bytes()
+	publicKeys := &PublicKeyPackage{
+		Threshold:       threshold,
+		GroupPublicKey:  groupPublicKey,
+		VerifyingShares: make(map[uint16][]byte, n),
+	}
+	shares := make([]*KeyShare, n)
+	for i := 1; i <= n; i++ {
+		id := uint16(i)
+		signingShare := evaluatePolynomial(coefficients, id)
+		verifyingShare := new(edwards25519.Point).ScalarBaseMult(signingShare).Bytes()
+		shares[i-1] = &KeyShare{
+			Identifier:     id,
+			Threshold:      threshold,
+			SigningShare:   signingShare.Bytes(),
+			VerifyingShare: verifyingShare,
+			GroupPublicKey: groupPublicKey,
+		}
+		publicKeys.VerifyingShares[id] = verifyingShare
+	}
+	return shares, publicKeys, nil
+}
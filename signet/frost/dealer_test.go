@@ -0,0 +1,96 @@
+package frost
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"filippo.io/edwards25519"
+)
+
+// reconstructSecret interpola as partes fornecidas em zero (uso exclusivo dos testes).
+func reconstructSecret(t *testing.T, shares []*KeyShare) *edwards25519.Scalar {
+	t.Helper()
+	ids := make([]uint16, len(shares))
+	for i, share := range shares {
+		ids[i] = share.Identifier
+	}
+	secret := edwards25519.NewScalar()
+	for _, share := range shares {
+		s, err := decodeScalar(share.SigningShare)
+		if err != nil {
+			t.Fatalf("parte malformada: %v", err)
+		}
+		secret.MultiplyAdd(lagrangeCoefficient(share.Identifier, ids), s, secret)
+	}
+	return secret
+}
+
+// Testa que quaisquer t partes reconstroem a chave do grupo e que t-1 não bastam
+func TestGenerateKeyShares(t *testing.T) {
+	shares, publicKeys, err := GenerateKeyShares(nil, 3, 5)
+	if err != nil {
+		t.Fatalf("erro ao gerar partes: %v", err)
+	}
+	if len(shares) != 5 || len(publicKeys.VerifyingShares) != 5 {
+		t.Fatalf("esperava 5 partes, obteve %d", len(shares))
+	}
+	subsets := [][]*KeyShare{
+		{shares[0], shares[1], shares[2]},
+		{shares[4], shares[2], shares[0]},
+		{shares[1], shares[3], shares[4]},
+	}
+	for _, subset := range subsets {
+		secret := reconstructSecret(t, subset)
+		if !bytes.Equal(new(edwards25519.Point).ScalarBaseMult(secret).Bytes(), publicKeys.GroupPublicKey) {
+			t.Errorf("subconjunto de %d partes não reconstrói a chave do grupo", len(subset))
+		}
+	}
+	secret := reconstructSecret(t, shares[:2])
+	if bytes.Equal(new(edwards25519.Point).ScalarBaseMult(secret).Bytes(), publicKeys.GroupPublicKey) {
+		t.Error("t-1 partes não deveriam reconstruir a chave do grupo")
+	}
+	for _, share := range shares {
+		if _, err := NewLocalParticipant(share); err != nil {
+			t.Errorf("parte %d deveria ser válida: %v", share.Identifier, err)
+		}
+	}
+}
+
+// Testa que SplitKey preserva a chave pública Ed25519 existente
+func TestSplitKey_PreservesPublicKey(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	shares, publicKeys, err := SplitKey(nil, priv, 2, 3)
+	if err != nil {
+		t.Fatalf("erro ao dividir chave: %v", err)
+	}
+	if !pub.Equal(publicKeys.GroupPublicKey) {
+		t.Error("chave pública do grupo difere da chave original")
+	}
+	if !pub.Equal(shares[0].GroupPublicKey) {
+		t.Error("parte não carrega a chave pública do grupo")
+	}
+	if _, _, err := SplitKey(nil, priv[:10], 2, 3); !errors.Is(err, ErrInvalidKeyShare) {
+		t.Errorf("esperava ErrInvalidKeyShare, obteve: %v", err)
+	}
+}
+
+// Testa a validação dos parâmetros de limiar
+func TestGenerateKeyShares_InvalidThreshold(t *testing.T) {
+	testCases := []struct {
+		name         string
+		threshold, n int
+	}{
+		{"limiar 1", 1, 3},
+		{"limiar maior que n", 4, 3},
+		{"n zero", 2, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := GenerateKeyShares(nil, tc.threshold, tc.n); !errors.Is(err, ErrInvalidThreshold) {
+				t.Errorf("esperava ErrInvalidThreshold, obteve: %v", err)
+			}
+		})
+	}
+}
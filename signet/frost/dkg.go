@@ -0,0 +1,226 @@
+package frost
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"filippo.io/edwards25519"
+)
+
+// DKGRound1Package é a mensagem da primeira rodada da geração distribuída de chaves,
+// enviada por difusão (broadcast) a todos os participantes.
+type DKGRound1Package struct {
+	// Identifier é o identificador do remetente.
+	Identifier uint16
+	// Commitment contém os compromissos G·a_j dos coeficientes do polinômio do remetente.
+	Commitment [][]byte
+	// ProofR e ProofZ formam a prova de conhecimento (Schnorr) do coeficiente a_0.
+	ProofR []byte
+	ProofZ []byte
+}
+
+// DKGRound2Package é a mensagem da segunda rodada, enviada por canal privado e autenticado
+// do participante From ao participante To.
+type DKGRound2Package struct {
+	From uint16
+	To   uint16
+	// SigningShare é a avaliação f_From(To) do polinômio do remetente. É secreto.
+	SigningShare []byte
+}
+
+// DKGParticipant conduz a geração distribuída de chaves (Pedersen DKG com provas de
+// conhecimento, como no artigo original do FROST) para um participante, sem distribuidor
+// confiável: a chave do grupo nunca existe em um único nó.
+//
+// Exemplo:
+//
+//	participant, round1, err := frost.NewDKGParticipant(nil, id, 2, 3)
+//	// difundir round1 e receber os pacotes dos demais participantes
+//	round2, err := participant.Round2(othersRound1)
+//	// enviar round2[i] em privado para round2[i].To e receber os pacotes destinados a id
+//	keyShare, publicKeys, err := participant.Finalize(receivedRound2)
+//
+// Após um Finalize bem-sucedido, o polinômio secreto é descartado e qualquer nova chamada
+// a Round2 ou Finalize retorna ErrDKGFinished.
+type DKGParticipant struct {
+	id           uint16
+	threshold    int
+	n            int
+	coefficients []*edwards25519.Scalar
+	commitment   []*edwards25519.Point
+	received     map[uint16][]*edwards25519.Point
+	finished     bool
+}
+
+// NewDKGParticipant inicia a geração distribuída de chaves para o participante id, no
+// intervalo [1, n], e retorna o pacote da primeira rodada.
+// Se random for nil, crypto/rand.Reader é usado.
+func NewDKGParticipant(random io.Reader, id uint16, threshold, n int) (*DKGParticipant, *DKGRound1Package, error) {
+	if err := validateThreshold(threshold, n); err != nil {
+		return nil, nil, err
+	}
+	if id == 0 || int(id) > n {
+		return nil, nil, fmt.Errorf("identificador %d: %w", id, ErrInvalidIdentifier)
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+	p := &DKGParticipant{id: id, threshold: threshold, n: n}
+	pkg := &DKGRound1Package{Identifier: id}
+	for i := 0; i < threshold; i++ {
+		c, err := randomScalar(random)
+		if err != nil {
+			return nil, nil, err
+		}
+		point := new(edwards25519.Point).ScalarBaseMult(c)
+		p.coefficients = append(p.coefficients, c)
+		p.commitment = append(p.commitment, point)
+		pkg.Commitment = append(pkg.Commitment, point.Bytes())
+	}
+	// Prova de conhecimento de a_0: impede ataques de chave desonesta (rogue key)
+	k, err := randomScalar(random)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(edwards25519.Point).ScalarBaseMult(k)
+	c := proofChallenge(id, p.commitment[0], r)
+	pkg.ProofR = r.Bytes()
+	pkg.ProofZ = edwards25519.NewScalar().MultiplyAdd(p.coefficients[0], c, k).Bytes()
+	return p, pkg, nil
+}
+
+// Round2 verifica os pacotes da primeira rodada dos demais n-1 participantes e retorna
+// as partes secretas a serem enviadas a cada um deles.
+func (p *DKGParticipant) Round2(round1 []*DKGRound1Package) ([]*DKGRound2Package, error) {
+	if p.finished {
+		return nil, ErrDKGFinished
+	}
+	if len(round1) != p.n-1 {
+		return nil, fmt.Errorf("esperava %d pacotes, recebeu %d: %w", p.n-1, len(round1), ErrInsufficientParticipants)
+	}
+	received := make(map[uint16][]*edwards25519.Point, len(round1))
+	for _, pkg := range round1 {
+		if pkg == nil || pkg.Identifier == 0 || int(pkg.Identifier) > p.n || pkg.Identifier == p.id {
+			return nil, ErrInvalidIdentifier
+		}
+		if _, dup := received[pkg.Identifier]; dup {
+			return nil, fmt.Errorf("identificador %d repetido: %w", pkg.Identifier, ErrInvalidIdentifier)
+		}
+		if len(pkg.Commitment) != p.threshold {
+			return nil, fmt.Errorf("participante %d: %w", pkg.Identifier, ErrInvalidCommitment)
+		}
+		commitment := make([]*edwards25519.Point, len(pkg.Commitment))
+		for i, b := range pkg.Commitment {
+			point, err := decodeElement(b)
+			if err != nil {
+				return nil, fmt.Errorf("participante %d: %w", pkg.Identifier, ErrInvalidCommitment)
+			}
+			commitment[i] = point
+		}
+		if err := verifyProof(pkg.Identifier, commitment[0], pkg.ProofR, pkg.ProofZ); err != nil {
+			return nil, fmt.Errorf("participante %d: %w", pkg.Identifier, err)
+		}
+		received[pkg.Identifier] = commitment
+	}
+	p.received = received
+
+	out := make([]*DKGRound2Package, 0, len(received))
+	for _, id := range sortedIdentifiers(received) {
+		out = append(out, &DKGRound2Package{
+			From:         p.id,
+			To:           id,
+			SigningShare: evaluatePolynomial(p.coefficients, id).Bytes(),
+		})
+	}
+	return out, nil
+}
+
+// Finalize verifica as partes recebidas na segunda rodada contra os compromissos da primeira
+// e deriva a parte de chave do participante e o pacote de chaves públicas do grupo.
+func (p *DKGParticipant) Finalize(round2 []*DKGRound2Package) (*KeyShare, *PublicKeyPackage, error) {
+	if p.finished {
+		return nil, nil, ErrDKGFinished
+	}
+	if p.received == nil {
+		return nil, nil, fmt.Errorf("Round2 não executado: %w", ErrInvalidKeyShare)
+	}
+	if len(round2) != len(p.received) {
+		return nil, nil, fmt.Errorf("esperava %d pacotes, recebeu %d: %w", len(p.received), len(round2), ErrInsufficientParticipants)
+	}
+	signingShare := evaluatePolynomial(p.coefficients, p.id)
+	seen := make(map[uint16]bool, len(round2))
+	for _, pkg := range round2 {
+		if pkg == nil || pkg.To != p.id || seen[pkg.From] {
+			return nil, nil, ErrInvalidIdentifier
+		}
+		commitment, ok := p.received[pkg.From]
+		if !ok {
+			return nil, nil, fmt.Errorf("participante %d: %w", pkg.From, ErrInvalidIdentifier)
+		}
+		seen[pkg.From] = true
+		share, err := decodeScalar(pkg.SigningShare)
+		if err != nil {
+			return nil, nil, fmt.Errorf("participante %d: %w", pkg.From, ErrInvalidKeyShare)
+		}
+		// G·f_l(i) deve coincidir com o polinômio comprometido na primeira rodada
+		if new(edwards25519.Point).ScalarBaseMult(share).Equal(evaluateCommitment(commitment, p.id)) != 1 {
+			return nil, nil, fmt.Errorf("participante %d: %w", pkg.From, ErrInvalidKeyShare)
+		}
+		signingShare.Add(signingShare, share)
+	}
+
+	commitments := make(map[uint16][]*edwards25519.Point, len(p.received)+1)
+	for id, c := range p.received {
+		commitments[id] = c
+	}
+	commitments[p.id] = p.commitment
+	groupPublicKey := edwards25519.NewIdentityPoint()
+	for _, c := range commitments {
+		groupPublicKey.Add(groupPublicKey, c[0])
+	}
+	publicKeys := &PublicKeyPackage{
+		Threshold:       p.threshold,
+		GroupPublicKey:  groupPublicKey.Bytes(),
+		VerifyingShares: make(map[uint16][]byte, p.n),
+	}
+	for i := 1; i <= p.n; i++ {
+		verifyingShare := edwards25519.NewIdentityPoint()
+		for _, c := range commitments {
+			verifyingShare.Add(verifyingShare, evaluateCommitment(c, uint16(i)))
+		}
+		publicKeys.VerifyingShares[uint16(i)] = verifyingShare.Bytes()
+	}
+	p.coefficients = nil
+	p.finished = true
+	return &KeyShare{
+		Identifier:     p.id,
+		Threshold:      p.threshold,
+		SigningShare:   signingShare.Bytes(),
+		VerifyingShare: publicKeys.VerifyingShares[p.id],
+		GroupPublicKey: publicKeys.GroupPublicKey,
+	}, publicKeys, nil
+}
+
+// proofChallenge calcula o desafio da prova de conhecimento de a_0 do participante id.
+func proofChallenge(id uint16, secretCommitment, r *edwards25519.Point) *edwards25519.Scalar {
+	return hashToScalar([]byte(contextString+"dkg"), identifierScalar(id).Bytes(), secretCommitment.Bytes(), r.Bytes())
+}
+
+// verifyProof verifica a prova de conhecimento: G·z == R + c·(G·a_0).
+func verifyProof(id uint16, secretCommitment *edwards25519.Point, proofR, proofZ []byte) error {
+	r, err := decodeElement(proofR)
+	if err != nil {
+		return ErrInvalidProof
+	}
+	z, err := decodeScalar(proofZ)
+	if err != nil {
+		return ErrInvalidProof
+	}
+	c := proofChallenge(id, secretCommitment, r)
+	expected := new(edwards25519.Point).Add(r, new(edwards25519.Point).ScalarMult(c, secretCommitment))
+	if new(edwards25519.Point).ScalarBaseMult(z).Equal(expected) != 1 {
+		return ErrInvalidProof
+	}
+	return nil
+}
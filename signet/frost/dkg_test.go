@@ -0,0 +1,142 @@
+package frost
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"filippo.io/edwards25519"
+)
+
+// runDKG simula a geração distribuída de chaves entre n participantes no mesmo processo.
+// tamper, se não nulo, pode alterar as mensagens da segunda rodada em trânsito.
+func runDKG(t *testing.T, threshold, n int, tamper func(*DKGRound2Package)) ([]*KeyShare, []*PublicKeyPackage, error) {
+	t.Helper()
+	participants := make([]*DKGParticipant, n)
+	round1 := make([]*DKGRound1Package, n)
+	for i := range participants {
+		p, pkg, err := NewDKGParticipant(nil, uint16(i+1), threshold, n)
+		if err != nil {
+			t.Fatalf("erro na primeira rodada: %v", err)
+		}
+		participants[i], round1[i] = p, pkg
+	}
+	inbox := make(map[uint16][]*DKGRound2Package, n)
+	for i, p := range participants {
+		others := append(append([]*DKGRound1Package{}, round1[:i]...), round1[i+1:]...)
+		out, err := p.Round2(others)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, pkg := range out {
+			if tamper != nil {
+				tamper(pkg)
+			}
+			inbox[pkg.To] = append(inbox[pkg.To], pkg)
+		}
+	}
+	shares := make([]*KeyShare, n)
+	publicKeys := make([]*PublicKeyPackage, n)
+	for i, p := range participants {
+		share, pub, err := p.Finalize(inbox[uint16(i+1)])
+		if err != nil {
+			return nil, nil, err
+		}
+		shares[i], publicKeys[i] = share, pub
+	}
+	return shares, publicKeys, nil
+}
+
+// Testa que a DKG produz partes consistentes, sem distribuidor, utilizáveis para assinar
+func TestDKG_RoundTrip(t *testing.T) {
+	shares, publicKeys, err := runDKG(t, 2, 3, nil)
+	if err != nil {
+		t.Fatalf("erro na DKG: %v", err)
+	}
+	for i, pub := range publicKeys {
+		if !bytes.Equal(pub.GroupPublicKey, publicKeys[0].GroupPublicKey) {
+			t.Fatalf("participante %d derivou outra chave do grupo", i+1)
+		}
+		for id, vs := range pub.VerifyingShares {
+			if !bytes.Equal(vs, publicKeys[0].VerifyingShares[id]) {
+				t.Fatalf("participante %d derivou outra parte pública para %d", i+1, id)
+			}
+		}
+	}
+	secret := reconstructSecret(t, []*KeyShare{shares[2], shares[0]})
+	if !bytes.Equal(new(edwards25519.Point).ScalarBaseMult(secret).Bytes(), publicKeys[0].GroupPublicKey) {
+		t.Error("partes da DKG não reconstroem a chave do grupo")
+	}
+
+	coordinator := newTestCoordinator(t, shares, publicKeys[0])
+	message := []byte("mensagem")
+	signature, err := coordinator.Sign(nil, message, &ed25519.Options{})
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	if !ed25519.Verify(publicKeys[0].GroupPublicKey, message, signature) {
+		t.Error("assinatura inválida para a chave do grupo gerada pela DKG")
+	}
+}
+
+// Testa a detecção de mensagens adulteradas e de participantes desonestos
+func TestDKG_Errors(t *testing.T) {
+	// Parte secreta adulterada na segunda rodada
+	_, _, err := runDKG(t, 2, 3, func(pkg *DKGRound2Package) {
+		if pkg.From == 2 && pkg.To == 3 {
+			s, _ := decodeScalar(pkg.SigningShare)
+			pkg.SigningShare = s.Add(s, identifierScalar(1)).Bytes()
+		}
+	})
+	if !errors.Is(err, ErrInvalidKeyShare) {
+		t.Errorf("esperava ErrInvalidKeyShare, obteve: %v", err)
+	}
+
+	// Prova de conhecimento inválida
+	p1, _, _ := NewDKGParticipant(nil, 1, 2, 2)
+	_, pkg2, _ := NewDKGParticipant(nil, 2, 2, 2)
+	_, pkg3, _ := NewDKGParticipant(nil, 2, 2, 2)
+	pkg2.ProofZ = pkg3.ProofZ
+	if _, err := p1.Round2([]*DKGRound1Package{pkg2}); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("esperava ErrInvalidProof, obteve: %v", err)
+	}
+
+	// Identificadores inválidos
+	if _, _, err := NewDKGParticipant(nil, 0, 2, 3); !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("esperava ErrInvalidIdentifier, obteve: %v", err)
+	}
+	_, own, _ := NewDKGParticipant(nil, 1, 2, 2)
+	if _, err := p1.Round2([]*DKGRound1Package{own}); !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("esperava ErrInvalidIdentifier, obteve: %v", err)
+	}
+	if _, err := p1.Round2(nil); !errors.Is(err, ErrInsufficientParticipants) {
+		t.Errorf("esperava ErrInsufficientParticipants, obteve: %v", err)
+	}
+}
+
+// Testa que o participante não pode ser reutilizado após Finalize
+func TestDKG_FinishedParticipant(t *testing.T) {
+	p1, pkg1, _ := NewDKGParticipant(nil, 1, 2, 2)
+	p2, pkg2, _ := NewDKGParticipant(nil, 2, 2, 2)
+	out1, err := p1.Round2([]*DKGRound1Package{pkg2})
+	if err != nil {
+		t.Fatalf("erro na segunda rodada: %v", err)
+	}
+	out2, err := p2.Round2([]*DKGRound1Package{pkg1})
+	if err != nil {
+		t.Fatalf("erro na segunda rodada: %v", err)
+	}
+	if _, _, err := p1.Finalize(out2); err != nil {
+		t.Fatalf("erro ao finalizar: %v", err)
+	}
+	if _, _, err := p1.Finalize(out2); !errors.Is(err, ErrDKGFinished) {
+		t.Errorf("esperava ErrDKGFinished no segundo Finalize, obteve: %v", err)
+	}
+	if _, err := p1.Round2([]*DKGRound1Package{pkg2}); !errors.Is(err, ErrDKGFinished) {
+		t.Errorf("esperava ErrDKGFinished no Round2 após Finalize, obteve: %v", err)
+	}
+	if _, _, err := p2.Finalize(out1); err != nil {
+		t.Errorf("o outro participante deveria finalizar normalmente: %v", err)
+	}
+}
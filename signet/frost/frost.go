@@ -0,0 +1,199 @@
+// Package frost implementa assinatura por limiar FROST(Ed25519, SHA-512), conforme a RFC 9591.
+//
+// A chave de assinatura do emissor é dividida entre n participantes, e quaisquer t deles
+// cooperam em duas rodadas (compromissos de nonce e partes de assinatura) para produzir uma
+// assinatura Ed25519 comum. Nenhum nó mantém a chave completa, e os verificadores não mudam:
+// o token é aceito por signet.Parse com a chave pública do grupo.
+//
+// As partes de chave são geradas por um distribuidor confiável (GenerateKeyShares, SplitKey)
+// ou por geração distribuída sem distribuidor (NewDKGParticipant). O Coordinator implementa
+// crypto.Signer e signet.ContextSigner e se integra ao emissor via PayloadBuilder.SignWith.
+//
+// Exemplo:
+//
+//	shares, publicKeys, _ := frost.GenerateKeyShares(nil, 2, 3)
+//	participants := make([]frost.Participant, len(shares))
+//	for i, share := range shares {
+//	    participants[i], _ = frost.NewLocalParticipant(share)
+//	}
+//	coordinator, _ := frost.NewCoordinator(publicKeys, participants...)
+//	tokenBytes, err := signet.NewPayload().WithKeyID("frost-v1").SignWith(ctx, coordinator)
+package frost
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"filippo.io/edwards25519"
+)
+
+// contextString é o identificador do ciphersuite FROST(Ed25519, SHA-512) da RFC 9591.
+const contextString = "FROST-ED25519-SHA512-v1"
+
+var (
+	// ErrInvalidThreshold indica parâmetros de limiar fora do intervalo 2 <= t <= n.
+	ErrInvalidThreshold = errors.New("frost: limiar inválido, exige 2 <= t <= n")
+	// ErrInvalidIdentifier indica um identificador de participante nulo, repetido ou fora do intervalo.
+	ErrInvalidIdentifier = errors.New("frost: identificador de participante inválido")
+	// ErrInvalidKeyShare indica uma parte de chave malformada ou inconsistente com a chave pública.
+	ErrInvalidKeyShare = errors.New("frost: parte de chave inválida")
+	// ErrInvalidCommitment indica um compromisso de nonce malformado ou um conjunto de compromissos inválido.
+	ErrInvalidCommitment = errors.New("frost: compromisso de nonce inválido")
+	// ErrNonceUnavailable indica um compromisso sem nonces pendentes: desconhecido ou já utilizado.
+	ErrNonceUnavailable = errors.New("frost: nonces ausentes ou já utilizados para o compromisso")
+	// ErrInsufficientParticipants indica que menos de t participantes responderam.
+	ErrInsufficientParticipants = errors.New("frost: número de participantes inferior ao limiar")
+	// ErrInvalidSignatureShare indica uma parte de assinatura que não confere com a parte pública do participante.
+	ErrInvalidSignatureShare = errors.New("frost: parte de assinatura inválida")
+	// ErrInvalidProof indica uma prova de conhecimento inválida na geração distribuída de chaves.
+	ErrInvalidProof = errors.New("frost: prova de conhecimento inválida")
+	// ErrDKGFinished indica uma chamada a um DKGParticipant após Finalize, quando o polinômio secreto já foi descartado.
+	ErrDKGFinished = errors.New("frost: geração distribuída de chaves já finalizada")
+	// ErrUnsupportedSignerOpts indica opções de assinatura diferentes de Ed25519 puro (crypto.Hash(0)).
+	ErrUnsupportedSignerOpts = errors.New("frost: apenas Ed25519 puro é suportado")
+)
+
+// KeyShare é a parte da chave de um participante.
+// SigningShare é secreto e nunca deve deixar o nó do participante.
+type KeyShare struct {
+	// Identifier é o identificador do participante, no intervalo [1, n].
+	Identifier uint16
+	// Threshold é o número mínimo de participantes necessários para assinar.
+	Threshold int
+	// SigningShare é o escalar secreto do participante (32 bytes, little-endian).
+	SigningShare []byte
+	// VerifyingShare é a parte pública correspondente a SigningShare.
+	VerifyingShare []byte
+	// GroupPublicKey é a chave pública Ed25519 do grupo.
+	GroupPublicKey ed25519.PublicKey
+}
+
+// PublicKeyPackage reúne as informações públicas do grupo, usadas pelo coordenador
+// para verificar as partes de assinatura de cada participante.
+type PublicKeyPackage struct {
+	// Threshold é o número mínimo de participantes necessários para assinar.
+	Threshold int
+	// GroupPublicKey é a chave pública Ed25519 do grupo, publicada aos verificadores.
+	GroupPublicKey ed25519.PublicKey
+	// VerifyingShares mapeia o identificador de cada participante para sua parte pública.
+	VerifyingShares map[uint16][]byte
+}
+
+// validateThreshold verifica os parâmetros de limiar.
+func validateThreshold(threshold, n int) error {
+	if threshold < 2 || threshold > n || n > 0xFFFF {
+		return fmt.Errorf("t=%d, n=%d: %w", threshold, n, ErrInvalidThreshold)
+	}
+	return nil
+}
+
+// identifierScalar converte o identificador de um participante para escalar.
+func identifierScalar(id uint16) *edwards25519.Scalar {
+	var b [32]byte
+	b[0], b[1] = byte(id), byte(id>>8)
+	s, _ := edwards25519.NewScalar().SetCanonicalBytes(b[:])
+	return s
+}
+
+// hashToScalar reduz SHA-512(parts...) módulo a ordem do grupo.
+func hashToScalar(parts ...[]byte) *edwards25519.Scalar {
+	h := sha512.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	s, _ := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	return s
+}
+
+// hashBytes retorna SHA-512(contextString || tag || m).
+func hashBytes(tag string, m []byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(contextString + tag))
+	h.Write(m)
+	return h.Sum(nil)
+}
+
+// randomScalar gera um escalar uniforme a partir de 64 bytes aleatórios.
+func randomScalar(rand io.Reader) (*edwards25519.Scalar, error) {
+	var b [64]byte
+	if _, err := io.ReadFull(rand, b[:]); err != nil {
+		return nil, err
+	}
+	return edwards25519.NewScalar().SetUniformBytes(b[:])
+}
+
+// decodeScalar decodifica um escalar em codificação canônica.
+func decodeScalar(b []byte) (*edwards25519.Scalar, error) {
+	return edwards25519.NewScalar().SetCanonicalBytes(b)
+}
+
+// decodeElement decodifica um ponto, rejeitando o elemento neutro e pontos fora do
+// subgrupo de ordem prima (RFC 9591, seção 6.5).
+func decodeElement(b []byte) (*edwards25519.Point, error) {
+	p, err := new(edwards25519.Point).SetBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	identity := edwards25519.NewIdentityPoint()
+	if p.Equal(identity) == 1 {
+		return nil, errors.New("elemento neutro")
+	}
+	// [L]P = [L-1]P + P deve ser o elemento neutro
+	lMinusOne := edwards25519.NewScalar().Subtract(edwards25519.NewScalar(), identifierScalar(1))
+	if new(edwards25519.Point).Add(new(edwards25519.Point).ScalarMult(lMinusOne, p), p).Equal(identity) != 1 {
+		return nil, errors.New("ponto fora do subgrupo de ordem prima")
+	}
+	return p, nil
+}
+
+// lagrangeCoefficient calcula o coeficiente de Lagrange do participante id no conjunto ids,
+// avaliado em zero.
+func lagrangeCoefficient(id uint16, ids []uint16) *edwards25519.Scalar {
+	xi := identifierScalar(id)
+	numerator := identifierScalar(1)
+	denominator := identifierScalar(1)
+	for _, other := range ids {
+		if other == id {
+			continue
+		}
+		xj := identifierScalar(other)
+		numerator.Multiply(numerator, xj)
+		denominator.Multiply(denominator, edwards25519.NewScalar().Subtract(xj, xi))
+	}
+	return numerator.Multiply(numerator, edwards25519.NewScalar().Invert(denominator))
+}
+
+// evaluateCommitment avalia o polinômio comprometido (G·a_0, G·a_1, ...) no ponto id.
+func evaluateCommitment(commitment []*edwards25519.Point, id uint16) *edwards25519.Point {
+	x := identifierScalar(id)
+	result := edwards25519.NewIdentityPoint()
+	for i := len(commitment) - 1; i >= 0; i-- {
+		result.ScalarMult(x, result)
+		result.Add(result, commitment[i])
+	}
+	return result
+}
+
+// evaluatePolynomial avalia o polinômio de coeficientes a_0, a_1, ... no ponto id.
+func evaluatePolynomial(coefficients []*edwards25519.Scalar, id uint16) *edwards25519.Scalar {
+	x := identifierScalar(id)
+	result := edwards25519.NewScalar()
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result.MultiplyAdd(result, x, coefficients[i])
+	}
+	return result
+}
+
+// sortedIdentifiers retorna as chaves do mapa em ordem crescente.
+func sortedIdentifiers[V any](m map[uint16]V) []uint16 {
+	ids := make([]uint16, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
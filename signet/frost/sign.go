@@ -0,0 +1,260 @@
+package frost
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+
+	"filippo.io/edwards25519"
+)
+
+// Commitment é o compromisso de nonces publicado por um participante na primeira rodada.
+type Commitment struct {
+	Identifier uint16
+	// Hiding e Binding são os compromissos G·d e G·e dos nonces do participante.
+	Hiding  []byte
+	Binding []byte
+}
+
+// SignatureShare é a parte de assinatura produzida por um participante na segunda rodada.
+type SignatureShare struct {
+	Identifier uint16
+	Share      []byte
+}
+
+// Participant é um participante do protocolo de assinatura, local ou remoto.
+// Implementações remotas encaminham as chamadas ao nó que mantém a parte da chave.
+type Participant interface {
+	// Identifier retorna o identificador do participante.
+	Identifier() uint16
+	// Commit executa a primeira rodada: gera nonces de uso único e retorna seus compromissos.
+	Commit(ctx context.Context) (*Commitment, error)
+	// SignShare executa a segunda rodada sobre a mensagem e o conjunto de compromissos
+	// selecionado pelo coordenador, consumindo os nonces do compromisso do participante.
+	SignShare(ctx context.Context, message []byte, commitments []*Commitment) (*SignatureShare, error)
+	// Discard descarta os nonces de um compromisso que não será assinado. O coordenador o
+	// chama quando abandona a assinatura após a primeira rodada.
+	Discard(ctx context.Context, commitment *Commitment) error
+}
+
+// maxPendingCommitments limita os compromissos pendentes de um LocalParticipant.
+const maxPendingCommitments = 64
+
+// signingNonces são os nonces secretos (d, e) de um compromisso pendente.
+type signingNonces struct {
+	hiding  *edwards25519.Scalar
+	binding *edwards25519.Scalar
+	// seq ordena os compromissos pendentes para o descarte do mais antigo.
+	seq uint64
+}
+
+// LocalParticipant implementa Participant para uma parte de chave mantida em memória.
+// Os nonces de cada compromisso são descartados após o primeiro uso, impedindo a
+// reutilização de nonces, que revelaria a parte da chave. No máximo 64 compromissos ficam
+// pendentes: além disso, o mais antigo é descartado e sua segunda rodada falha com
+// ErrNonceUnavailable. É seguro para uso concorrente.
+type LocalParticipant struct {
+	share    *KeyShare
+	secret   *edwards25519.Scalar
+	groupKey *edwards25519.Point
+	random   io.Reader
+	mu       sync.Mutex
+	pending  map[string]signingNonces
+	nextSeq  uint64
+}
+
+// NewLocalParticipant cria um participante a partir de sua parte de chave, verificando que
+// SigningShare corresponde a VerifyingShare.
+func NewLocalParticipant(share *KeyShare) (*LocalParticipant, error) {
+	if share == nil || share.Identifier == 0 {
+		return nil, ErrInvalidKeyShare
+	}
+	secret, err := decodeScalar(share.SigningShare)
+	if err != nil {
+		return nil, ErrInvalidKeyShare
+	}
+	verifyingShare, err := decodeElement(share.VerifyingShare)
+	if err != nil || new(edwards25519.Point).ScalarBaseMult(secret).Equal(verifyingShare) != 1 {
+		return nil, ErrInvalidKeyShare
+	}
+	groupKey, err := decodeElement(share.GroupPublicKey)
+	if err != nil {
+		return nil, ErrInvalidKeyShare
+	}
+	return &LocalParticipant{
+		share:    share,
+		secret:   secret,
+		groupKey: groupKey,
+		random:   rand.Reader,
+		pending:  make(map[string]signingNonces),
+	}, nil
+}
+
+// Identifier implementa Participant.
+func (p *LocalParticipant) Identifier() uint16 {
+	return p.share.Identifier
+}
+
+// Commit implementa Participant.
+func (p *LocalParticipant) Commit(ctx context.Context) (*Commitment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	hiding, err := p.generateNonce()
+	if err != nil {
+		return nil, err
+	}
+	binding, err := p.generateNonce()
+	if err != nil {
+		return nil, err
+	}
+	commitment := &Commitment{
+		Identifier: p.share.Identifier,
+		Hiding:     new(edwards25519.Point).ScalarBaseMult(hiding).Bytes(),
+		Binding:    new(edwards25519.Point).ScalarBaseMult(binding).Bytes(),
+	}
+	p.mu.Lock()
+	if len(p.pending) >= maxPendingCommitments {
+		p.evictOldest()
+	}
+	p.nextSeq++
+	p.pending[commitmentKey(commitment)] = signingNonces{hiding: hiding, binding: binding, seq: p.nextSeq}
+	p.mu.Unlock()
+	return commitment, nil
+}
+
+// evictOldest descarta o compromisso pendente mais antigo. Deve ser chamado com p.mu travado.
+func (p *LocalParticipant) evictOldest() {
+	var oldest string
+	var oldestSeq uint64
+	for key, nonces := range p.pending {
+		if oldestSeq == 0 || nonces.seq < oldestSeq {
+			oldest, oldestSeq = key, nonces.seq
+		}
+	}
+	delete(p.pending, oldest)
+}
+
+// Discard implementa Participant.
+func (p *LocalParticipant) Discard(_ context.Context, commitment *Commitment) error {
+	if commitment == nil {
+		return ErrInvalidCommitment
+	}
+	p.mu.Lock()
+	delete(p.pending, commitmentKey(commitment))
+	p.mu.Unlock()
+	return nil
+}
+
+// generateNonce implementa nonce_generate da RFC 9591: H3(random_bytes || SerializeScalar(secret)),
+// protegendo contra geradores aleatórios fracos.
+func (p *LocalParticipant) generateNonce() (*edwards25519.Scalar, error) {
+	var randomBytes [32]byte
+	if _, err := io.ReadFull(p.random, randomBytes[:]); err != nil {
+		return nil, err
+	}
+	return hashToScalar([]byte(contextString+"nonce"), randomBytes[:], p.secret.Bytes()), nil
+}
+
+// SignShare implementa Participant.
+func (p *LocalParticipant) SignShare(ctx context.Context, message []byte, commitments []*Commitment) (*SignatureShare, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var own *Commitment
+	for _, c := range commitments {
+		if c != nil && c.Identifier == p.share.Identifier {
+			own = c
+			break
+		}
+	}
+	if own == nil {
+		return nil, fmt.Errorf("participante %d ausente do conjunto: %w", p.share.Identifier, ErrInvalidCommitment)
+	}
+	// Os nonces são consumidos mesmo que a assinatura falhe adiante
+	p.mu.Lock()
+	nonces, ok := p.pending[commitmentKey(own)]
+	delete(p.pending, commitmentKey(own))
+	p.mu.Unlock()
+	if !ok {
+		return nil, ErrNonceUnavailable
+	}
+	sp, err := newSigningPackage(p.groupKey, p.share.Threshold, message, commitments)
+	if err != nil {
+		return nil, err
+	}
+	// z_i = d_i + e_i·ρ_i + λ_i·s_i·c
+	lambda := lagrangeCoefficient(p.share.Identifier, sp.ids)
+	z := edwards25519.NewScalar().MultiplyAdd(nonces.binding, sp.bindingFactors[p.share.Identifier], nonces.hiding)
+	z.MultiplyAdd(edwards25519.NewScalar().Multiply(lambda, p.secret), sp.challenge, z)
+	return &SignatureShare{Identifier: p.share.Identifier, Share: z.Bytes()}, nil
+}
+
+// commitmentKey indexa os nonces pendentes pelos bytes do compromisso.
+func commitmentKey(c *Commitment) string {
+	return string(c.Hiding) + string(c.Binding)
+}
+
+// signingPackage reúne os valores derivados do conjunto de compromissos de uma assinatura.
+type signingPackage struct {
+	ids             []uint16
+	hiding          map[uint16]*edwards25519.Point
+	binding         map[uint16]*edwards25519.Point
+	bindingFactors  map[uint16]*edwards25519.Scalar
+	groupCommitment *edwards25519.Point
+	challenge       *edwards25519.Scalar
+}
+
+// newSigningPackage valida o conjunto de compromissos e calcula os fatores de vinculação,
+// o compromisso do grupo R e o desafio c = H2(R || PK || msg) (RFC 9591, seção 4).
+func newSigningPackage(groupKey *edwards25519.Point, threshold int, message []byte, commitments []*Commitment) (*signingPackage, error) {
+	if len(commitments) < threshold {
+		return nil, fmt.Errorf("%d compromissos para limiar %d: %w", len(commitments), threshold, ErrInsufficientParticipants)
+	}
+	sp := &signingPackage{
+		hiding:         make(map[uint16]*edwards25519.Point, len(commitments)),
+		binding:        make(map[uint16]*edwards25519.Point, len(commitments)),
+		bindingFactors: make(map[uint16]*edwards25519.Scalar, len(commitments)),
+	}
+	for _, c := range commitments {
+		if c == nil || c.Identifier == 0 {
+			return nil, ErrInvalidIdentifier
+		}
+		if _, dup := sp.hiding[c.Identifier]; dup {
+			return nil, fmt.Errorf("identificador %d repetido: %w", c.Identifier, ErrInvalidIdentifier)
+		}
+		hiding, err := decodeElement(c.Hiding)
+		if err != nil {
+			return nil, fmt.Errorf("participante %d: %w", c.Identifier, ErrInvalidCommitment)
+		}
+		binding, err := decodeElement(c.Binding)
+		if err != nil {
+			return nil, fmt.Errorf("participante %d: %w", c.Identifier, ErrInvalidCommitment)
+		}
+		sp.hiding[c.Identifier] = hiding
+		sp.binding[c.Identifier] = binding
+	}
+	sp.ids = sortedIdentifiers(sp.hiding)
+
+	// encode_group_commitment_list: compromissos ordenados por identificador
+	var encoded []byte
+	for _, id := range sp.ids {
+		encoded = append(encoded, identifierScalar(id).Bytes()...)
+		encoded = append(encoded, sp.hiding[id].Bytes()...)
+		encoded = append(encoded, sp.binding[id].Bytes()...)
+	}
+	prefix := append(append(append([]byte{}, groupKey.Bytes()...), hashBytes("msg", message)...), hashBytes("com", encoded)...)
+
+	sp.groupCommitment = edwards25519.NewIdentityPoint()
+	for _, id := range sp.ids {
+		rho := hashToScalar([]byte(contextString+"rho"), prefix, identifierScalar(id).Bytes())
+		sp.bindingFactors[id] = rho
+		sp.groupCommitment.Add(sp.groupCommitment, sp.hiding[id])
+		sp.groupCommitment.Add(sp.groupCommitment, new(edwards25519.Point).ScalarMult(rho, sp.binding[id]))
+	}
+	// H2 do ciphersuite Ed25519 não usa contextString, coincidindo com o desafio do RFC 8032
+	sp.challenge = hashToScalar(sp.groupCommitment.Bytes(), groupKey.Bytes(), message)
+	return sp, nil
+}
@@ -0,0 +1,160 @@
+package frost
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"filippo.io/edwards25519"
+)
+
+// newLocalParticipants cria participantes locais para as partes fornecidas.
+func newLocalParticipants(t *testing.T, shares []*KeyShare) []*LocalParticipant {
+	t.Helper()
+	participants := make([]*LocalParticipant, len(shares))
+	for i, share := range shares {
+		p, err := NewLocalParticipant(share)
+		if err != nil {
+			t.Fatalf("erro ao criar participante %d: %v", share.Identifier, err)
+		}
+		participants[i] = p
+	}
+	return participants
+}
+
+// Testa as duas rodadas conduzidas manualmente e a agregação em uma assinatura Ed25519
+func TestSignShare_Aggregate(t *testing.T) {
+	ctx := context.Background()
+	shares, publicKeys, _ := GenerateKeyShares(nil, 2, 3)
+	participants := newLocalParticipants(t, shares)
+	message := []byte("mensagem")
+
+	signers := []*LocalParticipant{participants[2], participants[0]}
+	var commitments []*Commitment
+	for _, p := range signers {
+		c, err := p.Commit(ctx)
+		if err != nil {
+			t.Fatalf("erro na primeira rodada: %v", err)
+		}
+		commitments = append(commitments, c)
+	}
+	var sigShares []*SignatureShare
+	for _, p := range signers {
+		share, err := p.SignShare(ctx, message, commitments)
+		if err != nil {
+			t.Fatalf("erro na segunda rodada: %v", err)
+		}
+		sigShares = append(sigShares, share)
+	}
+	signature, err := Aggregate(publicKeys, message, commitments, sigShares)
+	if err != nil {
+		t.Fatalf("erro ao agregar: %v", err)
+	}
+	if !ed25519.Verify(publicKeys.GroupPublicKey, message, signature) {
+		t.Error("assinatura agregada inválida")
+	}
+
+	// Os nonces de um compromisso não podem ser reutilizados
+	if _, err := signers[0].SignShare(ctx, []byte("outra mensagem"), commitments); !errors.Is(err, ErrNonceUnavailable) {
+		t.Errorf("esperava ErrNonceUnavailable, obteve: %v", err)
+	}
+	// Participante fora do conjunto de compromissos
+	if _, err := participants[1].SignShare(ctx, message, commitments); !errors.Is(err, ErrInvalidCommitment) {
+		t.Errorf("esperava ErrInvalidCommitment, obteve: %v", err)
+	}
+}
+
+// Testa que a agregação identifica partes de assinatura inválidas e conjuntos malformados
+func TestAggregate_Errors(t *testing.T) {
+	ctx := context.Background()
+	shares, publicKeys, _ := GenerateKeyShares(nil, 2, 3)
+	participants := newLocalParticipants(t, shares)
+	message := []byte("mensagem")
+
+	c1, _ := participants[0].Commit(ctx)
+	c2, _ := participants[1].Commit(ctx)
+	commitments := []*Commitment{c1, c2}
+	s1, _ := participants[0].SignShare(ctx, message, commitments)
+	s2, _ := participants[1].SignShare(ctx, message, commitments)
+
+	z, _ := decodeScalar(s2.Share)
+	tampered := &SignatureShare{Identifier: 2, Share: z.Add(z, identifierScalar(1)).Bytes()}
+	identity := edwards25519.NewIdentityPoint().Bytes()
+
+	testCases := []struct {
+		name          string
+		commitments   []*Commitment
+		shares        []*SignatureShare
+		expectedError error
+	}{
+		{"Sucesso", commitments, []*SignatureShare{s1, s2}, nil},
+		{"Falha: parte adulterada", commitments, []*SignatureShare{s1, tampered}, ErrInvalidSignatureShare},
+		{"Sucesso: partes fora de ordem", commitments, []*SignatureShare{s2, s1}, nil},
+		{"Falha: parte ausente", commitments, []*SignatureShare{s1}, ErrInvalidSignatureShare},
+		{"Falha: parte repetida", commitments, []*SignatureShare{s1, s1}, ErrInvalidSignatureShare},
+		{"Falha: compromissos abaixo do limiar", []*Commitment{c1}, []*SignatureShare{s1}, ErrInsufficientParticipants},
+		{"Falha: compromisso com elemento neutro", []*Commitment{c1, {Identifier: 2, Hiding: identity, Binding: c2.Binding}}, []*SignatureShare{s1, s2}, ErrInvalidCommitment},
+		{"Falha: identificador repetido", []*Commitment{c1, c1}, []*SignatureShare{s1, s2}, ErrInvalidIdentifier},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Aggregate(publicKeys, message, tc.commitments, tc.shares)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	if _, err := Aggregate(publicKeys, []byte("outra mensagem"), commitments, []*SignatureShare{s1, s2}); !errors.Is(err, ErrInvalidSignatureShare) {
+		t.Errorf("esperava ErrInvalidSignatureShare para mensagem diferente, obteve: %v", err)
+	}
+}
+
+// Testa o descarte explícito e o limite de compromissos pendentes
+func TestLocalParticipant_PendingNonces(t *testing.T) {
+	ctx := context.Background()
+	shares, _, _ := GenerateKeyShares(nil, 2, 3)
+	participants := newLocalParticipants(t, shares)
+	p, other := participants[0], participants[1]
+	otherCommitment, _ := other.Commit(ctx)
+
+	// Um compromisso descartado não pode mais ser assinado
+	discarded, _ := p.Commit(ctx)
+	if err := p.Discard(ctx, discarded); err != nil {
+		t.Fatalf("erro ao descartar: %v", err)
+	}
+	if _, err := p.SignShare(ctx, []byte("mensagem"), []*Commitment{discarded, otherCommitment}); !errors.Is(err, ErrNonceUnavailable) {
+		t.Errorf("esperava ErrNonceUnavailable, obteve: %v", err)
+	}
+	if err := p.Discard(ctx, nil); !errors.Is(err, ErrInvalidCommitment) {
+		t.Errorf("esperava ErrInvalidCommitment, obteve: %v", err)
+	}
+
+	// Além do limite, o compromisso mais antigo é descartado
+	oldest, _ := p.Commit(ctx)
+	for range maxPendingCommitments {
+		if _, err := p.Commit(ctx); err != nil {
+			t.Fatalf("erro na primeira rodada: %v", err)
+		}
+	}
+	if len(p.pending) != maxPendingCommitments {
+		t.Errorf("esperava %d compromissos pendentes, obteve %d", maxPendingCommitments, len(p.pending))
+	}
+	if _, err := p.SignShare(ctx, []byte("mensagem"), []*Commitment{oldest, otherCommitment}); !errors.Is(err, ErrNonceUnavailable) {
+		t.Errorf("esperava ErrNonceUnavailable, obteve: %v", err)
+	}
+}
+
+// Testa a rejeição de partes de chave inconsistentes
+func TestNewLocalParticipant_InvalidShare(t *testing.T) {
+	shares, _, _ := GenerateKeyShares(nil, 2, 3)
+	bad := *shares[0]
+	bad.VerifyingShare = shares[1].VerifyingShare
+	if _, err := NewLocalParticipant(&bad); !errors.Is(err, ErrInvalidKeyShare) {
+		t.Errorf("esperava ErrInvalidKeyShare, obteve: %v", err)
+	}
+	if _, err := NewLocalParticipant(nil); !errors.Is(err, ErrInvalidKeyShare) {
+		t.Errorf("esperava ErrInvalidKeyShare, obteve: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package signet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+)
+
+// AlgHS256 identifica o perfil simétrico HMAC-SHA256, destinado a saltos internos de alto
+// volume entre serviços que compartilham um segredo. Só é aceito pelo Parse com AllowHMAC.
+const AlgHS256 = core.AlgHS256
+
+// MinHMACSecretSize é o tamanho mínimo, em bytes, de um segredo HMAC-SHA256.
+const MinHMACSecretSize = core.MinHMACSecretSize
+
+// SecretResolverFunc é o análogo de KeyResolverFunc para o perfil HMAC: resolve o segredo
+// compartilhado com base no 'kid' do token.
+//
+// NOTA DE SEGURANÇA: segredos HMAC permitem emitir tokens, não apenas validá-los.
+// DEVEM ser distintos de qualquer chave pública e restritos aos serviços do cluster.
+//
+// Exemplo:
+//
+//	secretResolver := func(ctx context.Context, kid string) ([]byte, error) {
+//	    secret, ok := clusterSecrets[kid]
+//	    if !ok {
+//	        return nil, signet.ErrUnknownKeyID
+//	    }
+//	    return secret, nil
+//	}
+type SecretResolverFunc func(ctx context.Context, kid string) ([]byte, error)
+
+// ResolveSecret implementa SecretResolver, ignorando o emissor do pedido.
+func (f SecretResolverFunc) ResolveSecret(ctx context.Context, req KeyRequest) ([]byte, error) {
+	return f(ctx, req.KeyID)
+}
+
+// SecretResolver é o análogo de KeyResolver para o perfil HMAC: resolve o segredo
+// compartilhado a partir do pedido completo (emissor e kid), como no caminho assimétrico.
+type SecretResolver interface {
+	ResolveSecret(ctx context.Context, req KeyRequest) ([]byte, error)
+}
+
+// IssuerSecretResolverFunc é a variante de SecretResolverFunc com escopo de emissor: recebe
+// o iss e o kid do token, de modo que kids precisam ser únicos apenas dentro de cada emissor.
+// Deve ser combinada com WithIssuer para restringir os emissores aceitos.
+//
+// Exemplo:
+//
+//	secretResolver := signet.IssuerSecretResolverFunc(func(ctx context.Context, iss, kid string) ([]byte, error) {
+//	    secret, ok := secretsByIssuer[iss][kid]
+//	    if !ok {
+//	        return nil, signet.ErrUnknownKeyID
+//	    }
+//	    return secret, nil
+//	})
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver,
+//	    signet.WithIssuer("pedidos.interno"), signet.AllowHMACWithResolver(secretResolver))
+type IssuerSecretResolverFunc func(ctx context.Context, iss, kid string) ([]byte, error)
+
+// ResolveSecret implementa SecretResolver.
+func (f IssuerSecretResolverFunc) ResolveSecret(ctx context.Context, req KeyRequest) ([]byte, error) {
+	return f(ctx, req.Issuer, req.KeyID)
+}
+
+// SignHMAC autentica o payload com HMAC-SHA256 usando o segredo compartilhado.
+// O segredo deve ter pelo menos MinHMACSecretSize bytes.
+//
+// Exemplo:
+//
+//	tokenBytes, err := signet.NewPayload().WithKeyID("cluster-v1").SignHMAC(secret)
+func (b *PayloadBuilder) SignHMAC(secret []byte) ([]byte, error) {
+	if len(secret) < MinHMACSecretSize {
+		return nil, ErrWeakSecret
+	}
+	return b.SignWithAlgorithm(AlgHS256, secret)
+}
+
+// AllowHMAC habilita explicitamente a aceitação de tokens HMAC-SHA256, cujos segredos são
+// resolvidos por secretResolver (nunca pelo KeyResolver). Sem esta opção, o Parse rejeita
+// tokens HMAC com ErrHMACNotAllowed, impedindo o rebaixamento de assimétrico para simétrico.
+// Com WithHybridPolicy(HybridRequireBoth), tokens HMAC são rejeitados com
+// ErrHybridSignatureRequired, e NewValidator recusa a combinação.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.AllowHMAC(secretResolver))
+func AllowHMAC(secretResolver SecretResolverFunc) ValidationOption {
+	return AllowHMACWithResolver(secretResolver)
+}
+
+// AllowHMACWithResolver é equivalente a AllowHMAC, mas resolve os segredos através de um
+// SecretResolver, que recebe o emissor e o kid do token (ver IssuerSecretResolverFunc).
+// Um resolvedor nulo mantém os tokens HMAC rejeitados, e NewValidator o recusa.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.AllowHMACWithResolver(secretResolver))
+func AllowHMACWithResolver(secretResolver SecretResolver) ValidationOption {
+	return func(c *validationConfig) {
+		c.hmacSet = true
+		c.secretResolver = nil
+		if !isNilSecretResolver(secretResolver) {
+			c.secretResolver = secretResolver
+		}
+	}
+}
+
+// isNilSecretResolver detecta resolvedores nulos, inclusive funções nulas convertidas para a
+// interface, que seriam não nulas na comparação direta e entrariam em pânico ao serem chamadas.
+func isNilSecretResolver(secretResolver SecretResolver) bool {
+	switch fn := secretResolver.(type) {
+	case nil:
+		return true
+	case SecretResolverFunc:
+		return fn == nil
+	case IssuerSecretResolverFunc:
+		return fn == nil
+	}
+	return false
+}
+
+// verifyHMAC verifica o MAC de um token HS256 com o segredo resolvido por secretResolver para (iss, kid).
+func verifyHMAC(ctx context.Context, secretResolver SecretResolver, ref KeyRequest, message, mac []byte) (string, error) {
+	ref.Algorithm = AlgHS256
+	kid := ref.KeyID
+	secret, err := secretResolver.ResolveSecret(ctx, ref)
+	if err != nil {
+		return ReasonInvalidSignature, fmt.Errorf("falha ao resolver segredo HMAC para kid '%s': %w", kid, err)
+	}
+	if err := core.HS256.Verify(secret, message, mac); err != nil {
+		switch {
+		case errors.Is(err, core.ErrVerificationFailed):
+			return ReasonInvalidSignature, fmt.Errorf("falha na verificação do MAC: %w", ErrInvalidSignature)
+		case errors.Is(err, core.ErrWeakSecret):
+			return ReasonInvalidSignature, fmt.Errorf("segredo resolvido para kid '%s': %w", kid, ErrWeakSecret)
+		}
+		return ReasonInvalidSignature, fmt.Errorf("falha na verificação do MAC: %w", err)
+	}
+	return "", nil
+}
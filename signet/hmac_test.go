@@ -0,0 +1,130 @@
+package signet
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Testa o perfil HMAC: opt-in obrigatório, segredo correto e rejeição de rebaixamento
+func TestParse_HMAC(t *testing.T) {
+	edPub, edPriv, _ := ed25519.GenerateKey(nil)
+	secret := bytes.Repeat([]byte{0x7A}, MinHMACSecretSize)
+	tokenBytes, err := NewPayload().WithSubject("svc-a").WithKeyID("cluster-v1").SignHMAC(secret)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return edPub, nil
+	}
+	secretResolver := func(ctx context.Context, kid string) ([]byte, error) {
+		if kid != "cluster-v1" {
+			return nil, ErrUnknownKeyID
+		}
+		return secret, nil
+	}
+	otherSecret := func(ctx context.Context, kid string) ([]byte, error) {
+		return bytes.Repeat([]byte{0x01}, MinHMACSecretSize), nil
+	}
+	weakSecret := func(ctx context.Context, kid string) ([]byte, error) {
+		return []byte("curto"), nil
+	}
+	// Rebaixamento: token HS256 cujo "segredo" é a chave pública Ed25519 do emissor
+	forged, _ := NewPayload().WithSubject("atacante").SignWithAlgorithm(AlgHS256, append([]byte(nil), edPub...))
+	edToken, _ := NewPayload().Sign(edPriv)
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: HMAC habilitado", tokenBytes, []ValidationOption{AllowHMAC(secretResolver)}, nil},
+		{"Falha: HMAC sem habilitação", tokenBytes, nil, ErrHMACNotAllowed},
+		{"Falha: segredo incorreto", tokenBytes, []ValidationOption{AllowHMAC(otherSecret)}, ErrInvalidSignature},
+		{"Falha: segredo resolvido curto", tokenBytes, []ValidationOption{AllowHMAC(weakSecret)}, ErrWeakSecret},
+		{"Falha: rebaixamento com chave pública como segredo", forged, nil, ErrHMACNotAllowed},
+		{"Sucesso: Ed25519 com HMAC habilitado", edToken, []ValidationOption{AllowHMAC(secretResolver)}, nil},
+		{"Falha: HMAC sob HybridRequireBoth", tokenBytes, []ValidationOption{AllowHMAC(secretResolver), WithHybridPolicy(HybridRequireBoth)}, ErrHybridSignatureRequired},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Uma VerificationKey HMAC vinda do KeyResolver não contorna o opt-in
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		return VerificationKey{Algorithm: AlgHS256, Key: secret}, nil
+	})
+	if _, err := ParseWithResolver(context.Background(), tokenBytes, resolver); !errors.Is(err, ErrHMACNotAllowed) {
+		t.Errorf("esperava ErrHMACNotAllowed via KeyResolver, obteve: %v", err)
+	}
+	// Uma assinatura adicional HS256 não é verificada pelo KeyResolver
+	withCompanion := corruptToken(t, edToken, func(tok *signetv1.SignetToken) {
+		tok.Signatures = append(tok.Signatures, &signetv1.SignetSignature{Alg: AlgHS256, Signature: make([]byte, 32)})
+	})
+	if _, err := Parse(context.Background(), withCompanion, keyResolver); err != nil {
+		t.Errorf("assinatura adicional não deveria afetar a política padrão: %v", err)
+	}
+}
+
+// Testa a resolução de segredos HMAC com escopo de emissor
+func TestParse_HMACIssuerScoped(t *testing.T) {
+	edPub, _, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return edPub, nil
+	}
+	secrets := map[string][]byte{
+		"pedidos":     bytes.Repeat([]byte{0x11}, MinHMACSecretSize),
+		"faturamento": bytes.Repeat([]byte{0x22}, MinHMACSecretSize),
+	}
+	secretResolver := IssuerSecretResolverFunc(func(ctx context.Context, iss, kid string) ([]byte, error) {
+		secret, ok := secrets[iss]
+		if !ok || kid != "cluster-v1" {
+			return nil, ErrUnknownKeyID
+		}
+		return secret, nil
+	})
+	pedidos, _ := NewPayload().WithIssuer("pedidos").WithKeyID("cluster-v1").SignHMAC(secrets["pedidos"])
+	// Mesmo kid, mas emitido com o segredo de outro emissor
+	spoofed, _ := NewPayload().WithIssuer("faturamento").WithKeyID("cluster-v1").SignHMAC(secrets["pedidos"])
+	unknown, _ := NewPayload().WithIssuer("desconhecido").WithKeyID("cluster-v1").SignHMAC(secrets["pedidos"])
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: segredo do emissor", pedidos, []ValidationOption{AllowHMACWithResolver(secretResolver)}, nil},
+		{"Falha: segredo de outro emissor", spoofed, []ValidationOption{AllowHMACWithResolver(secretResolver)}, ErrInvalidSignature},
+		{"Falha: emissor sem segredo", unknown, []ValidationOption{AllowHMACWithResolver(secretResolver)}, ErrUnknownKeyID},
+		{"Falha: resolver nulo", pedidos, []ValidationOption{AllowHMAC(nil)}, ErrHMACNotAllowed},
+		{"Falha: IssuerSecretResolverFunc nula", pedidos, []ValidationOption{AllowHMACWithResolver(IssuerSecretResolverFunc(nil))}, ErrHMACNotAllowed},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+// Testa a exigência de tamanho mínimo do segredo na emissão
+func TestSignHMAC_WeakSecret(t *testing.T) {
+	_, err := NewPayload().SignHMAC([]byte("segredo-curto"))
+	if !errors.Is(err, ErrWeakSecret) {
+		t.Errorf("esperava ErrWeakSecret, obteve: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// HybridPolicy define como o Parse trata tokens híbridos, que carregam uma assinatura
+// clássica (Ed25519) e uma pós-quântica (ML-DSA-65) sobre os mesmos bytes do payload.
+// Permite migrar os validadores gradualmente durante a transição pós-quântica.
+type HybridPolicy int
+
+const (
+	// HybridClassicalOnly verifica apenas a assinatura principal e ignora assinaturas adicionais.
+	// É o comportamento padrão, compatível com validadores anteriores aos tokens híbridos.
+	HybridClassicalOnly HybridPolicy = iota
+	// HybridAcceptEither aceita o token se a assinatura clássica OU a pós-quântica for válida.
+	HybridAcceptEither
+	// HybridRequireBoth exige que a assinatura clássica E a pós-quântica estejam presentes e válidas.
+	HybridRequireBoth
+)
+
+// WithHybridPolicy define a política de verificação de tokens híbridos.
+//
+// Exemplo:
+//
+//	payload, err := signet.ParseWithResolver(ctx, tokenBytes, resolver, signet.WithHybridPolicy(signet.HybridRequireBoth))
+func WithHybridPolicy(policy HybridPolicy) ValidationOption {
+	return func(c *validationConfig) {
+		c.hybridPolicy = policy
+	}
+}
+
+// SignHybrid assina o payload com Ed25519 (assinatura principal) e ML-DSA-65 (assinatura
+// adicional), ambas sobre os mesmos bytes. Validadores antigos continuam verificando a
+// assinatura Ed25519; validadores atualizados podem exigir a pós-quântica via WithHybridPolicy.
+//
+// Exemplo:
+//
+//	tokenBytes, err := signet.NewPayload().WithKeyID("hybrid-v1").SignHybrid(edPrivateKey, pqPrivateKey)
+func (b *PayloadBuilder) SignHybrid(classical ed25519.PrivateKey, postQuantum *mldsa65.PrivateKey) ([]byte, error) {
+	if postQuantum == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	return b.signToken(AlgEd25519, func(message []byte) ([]byte, error) {
+		return core.Ed25519.Sign(classical, message)
+	}, func(message []byte) (*signetv1.SignetSignature, error) {
+		pqSignature, err := core.MLDSA65.Sign(postQuantum, message)
+		if err != nil {
+			return nil, err
+		}
+		return &signetv1.SignetSignature{Alg: AlgMLDSA65, Signature: pqSignature}, nil
+	})
+}
+
+// HybridKeyResolver compõe os resolvers de chaves de um emissor híbrido: pedidos de chaves
+// pós-quânticas são encaminhados para postQuantum e os demais para classical.
+//
+// Exemplo:
+//
+//	resolver := signet.HybridKeyResolver(signet.KeyResolverFunc(edResolver), signet.MLDSA65KeyResolverFunc(pqResolver))
+func HybridKeyResolver(classical, postQuantum KeyResolver) KeyResolver {
+	return VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		if isPostQuantum(req.Algorithm) {
+			return postQuantum.ResolveKey(ctx, req)
+		}
+		return classical.ResolveKey(ctx, req)
+	})
+}
+
+// isPostQuantum indica se o algoritmo é pós-quântico.
+func isPostQuantum(alg string) bool {
+	return alg == AlgMLDSA65
+}
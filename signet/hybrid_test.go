@@ -0,0 +1,121 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"google.golang.org/protobuf/proto"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// corruptToken aplica uma modificação ao SignetToken serializado e o serializa novamente.
+func corruptToken(t *testing.T, tokenBytes []byte, mutate func(*signetv1.SignetToken)) []byte {
+	t.Helper()
+	var token signetv1.SignetToken
+	if err := proto.Unmarshal(tokenBytes, &token); err != nil {
+		t.Fatalf("erro ao deserializar token: %v", err)
+	}
+	mutate(&token)
+	out, err := proto.Marshal(&token)
+	if err != nil {
+		t.Fatalf("erro ao serializar token: %v", err)
+	}
+	return out
+}
+
+// Testa as políticas híbridas contra tokens híbridos, clássicos e parcialmente corrompidos
+func TestParse_HybridPolicies(t *testing.T) {
+	edPub, edPriv, _ := ed25519.GenerateKey(nil)
+	pqPub, pqPriv, _ := mldsa65.GenerateKey(nil)
+	resolver := HybridKeyResolver(
+		KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) { return edPub, nil }),
+		MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) { return pqPub.Bytes(), nil }),
+	)
+
+	hybrid, err := NewPayload().WithKeyID("hybrid-v1").SignHybrid(edPriv, pqPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	classical, _ := NewPayload().WithKeyID("hybrid-v1").Sign(edPriv)
+	badClassical := corruptToken(t, hybrid, func(tok *signetv1.SignetToken) { tok.Signature[0] ^= 0xFF })
+	badPQ := corruptToken(t, hybrid, func(tok *signetv1.SignetToken) { tok.Signatures[0].Signature[0] ^= 0xFF })
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		policy        HybridPolicy
+		expectedError error
+	}{
+		{"ClassicalOnly: híbrido", hybrid, HybridClassicalOnly, nil},
+		{"ClassicalOnly: clássico", classical, HybridClassicalOnly, nil},
+		{"ClassicalOnly: pós-quântica corrompida é ignorada", badPQ, HybridClassicalOnly, nil},
+		{"ClassicalOnly: clássica corrompida", badClassical, HybridClassicalOnly, ErrInvalidSignature},
+		{"RequireBoth: híbrido", hybrid, HybridRequireBoth, nil},
+		{"RequireBoth: clássico sem pós-quântica", classical, HybridRequireBoth, ErrHybridSignatureRequired},
+		{"RequireBoth: pós-quântica corrompida", badPQ, HybridRequireBoth, ErrInvalidSignature},
+		{"RequireBoth: clássica corrompida", badClassical, HybridRequireBoth, ErrInvalidSignature},
+		{"AcceptEither: híbrido", hybrid, HybridAcceptEither, nil},
+		{"AcceptEither: clássico", classical, HybridAcceptEither, nil},
+		{"AcceptEither: clássica corrompida", badClassical, HybridAcceptEither, nil},
+		{"AcceptEither: pós-quântica corrompida", badPQ, HybridAcceptEither, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseWithResolver(context.Background(), tc.token, resolver, WithHybridPolicy(tc.policy))
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Ambas corrompidas: nenhuma política aceita
+	bothBad := corruptToken(t, badClassical, func(tok *signetv1.SignetToken) { tok.Signatures[0].Signature[0] ^= 0xFF })
+	if _, err := ParseWithResolver(context.Background(), bothBad, resolver, WithHybridPolicy(HybridAcceptEither)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("esperava ErrInvalidSignature com ambas as assinaturas corrompidas, obteve: %v", err)
+	}
+}
+
+// Testa que validadores legados (KeyResolverFunc, política padrão) aceitam tokens híbridos
+func TestParse_HybridTokenWithLegacyVerifier(t *testing.T) {
+	edPub, edPriv, _ := ed25519.GenerateKey(nil)
+	_, pqPriv, _ := mldsa65.GenerateKey(nil)
+	tokenBytes, err := NewPayload().WithSubject("user-hybrid").SignHybrid(edPriv, pqPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return edPub, nil
+	}
+	payload, err := Parse(context.Background(), tokenBytes, keyResolver)
+	if err != nil {
+		t.Fatalf("validador legado deveria aceitar o token híbrido: %v", err)
+	}
+	if payload.Sub != "user-hybrid" {
+		t.Error("payload retornado não bate com o original")
+	}
+}
+
+// Testa que ambas as assinaturas de um token híbrido são vinculadas ao contexto de assinatura
+func TestSignHybrid_WithSigningContext(t *testing.T) {
+	edPub, edPriv, _ := ed25519.GenerateKey(nil)
+	pqPub, pqPriv, _ := mldsa65.GenerateKey(nil)
+	resolver := HybridKeyResolver(
+		KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) { return edPub, nil }),
+		MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) { return pqPub.Bytes(), nil }),
+	)
+	tokenBytes, err := NewPayload().WithSigningContext("signet.v1:producao").SignHybrid(edPriv, pqPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	if _, err := ParseWithResolver(context.Background(), tokenBytes, resolver, WithHybridPolicy(HybridRequireBoth), WithSigningContext("signet.v1:producao")); err != nil {
+		t.Errorf("token híbrido deveria ser aceito no mesmo contexto: %v", err)
+	}
+	if _, err := ParseWithResolver(context.Background(), tokenBytes, resolver, WithHybridPolicy(HybridAcceptEither), WithSigningContext("signet.v1:staging")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("esperava ErrInvalidSignature em outro contexto, obteve: %v", err)
+	}
+}
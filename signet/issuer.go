@@ -0,0 +1,50 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+)
+
+// WithIssuer exige que o claim iss do payload seja um dos emissores fornecidos.
+// A verificação ocorre antes da resolução de chaves: tokens de emissores não aceitos
+// são rejeitados com ErrIssuerMismatch sem consultar o KeyResolver.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithIssuer("auth.exemplo.com", "legacy.exemplo.com"))
+func WithIssuer(issuers ...string) ValidationOption {
+	return func(c *validationConfig) {
+		if c.allowedIssuers == nil {
+			c.allowedIssuers = make(map[string]struct{}, len(issuers))
+		}
+		for _, iss := range issuers {
+			c.allowedIssuers[iss] = struct{}{}
+		}
+	}
+}
+
+// IssuerKeyResolverFunc é a variante de KeyResolverFunc com escopo de emissor: recebe o iss
+// e o kid do token, de modo que kids precisam ser únicos apenas dentro de cada emissor.
+// As chaves retornadas são vinculadas ao algoritmo Ed25519.
+// Deve ser combinada com WithIssuer para restringir os emissores aceitos.
+//
+// Exemplo:
+//
+//	resolver := signet.IssuerKeyResolverFunc(func(ctx context.Context, iss, kid string) (ed25519.PublicKey, error) {
+//	    key, ok := keysByIssuer[iss][kid]
+//	    if !ok {
+//	        return nil, signet.ErrUnknownKeyID
+//	    }
+//	    return key, nil
+//	})
+//	payload, err := signet.ParseWithResolver(ctx, tokenBytes, resolver, signet.WithIssuer("auth.exemplo.com"))
+type IssuerKeyResolverFunc func(ctx context.Context, iss, kid string) (ed25519.PublicKey, error)
+
+// ResolveKey implementa KeyResolver.
+func (f IssuerKeyResolverFunc) ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+	pub, err := f(ctx, req.Issuer, req.KeyID)
+	if err != nil {
+		return VerificationKey{}, err
+	}
+	return VerificationKey{Algorithm: AlgEd25519, Key: pub}, nil
+}
@@ -0,0 +1,83 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+// Testa a lista de emissores aceitos e a resolução de chaves com escopo de emissor
+func TestParse_WithIssuer(t *testing.T) {
+	pubA, privA, _ := ed25519.GenerateKey(nil)
+	pubB, privB, _ := ed25519.GenerateKey(nil)
+	// O mesmo kid em emissores diferentes aponta para chaves diferentes
+	keys := map[string]map[string]ed25519.PublicKey{
+		"auth.exemplo.com":    {"v1": pubA},
+		"billing.exemplo.com": {"v1": pubB},
+	}
+	resolverCalls := 0
+	resolver := IssuerKeyResolverFunc(func(ctx context.Context, iss, kid string) (ed25519.PublicKey, error) {
+		resolverCalls++
+		key, ok := keys[iss][kid]
+		if !ok {
+			return nil, ErrUnknownKeyID
+		}
+		return key, nil
+	})
+
+	auth, _ := NewPayload().WithIssuer("auth.exemplo.com").WithKeyID("v1").Sign(privA)
+	billing, _ := NewPayload().WithIssuer("billing.exemplo.com").WithKeyID("v1").Sign(privB)
+	// Assinado pelo emissor billing, mas declarando o emissor auth
+	forged, _ := NewPayload().WithIssuer("auth.exemplo.com").WithKeyID("v1").Sign(privB)
+	noIssuer, _ := NewPayload().WithKeyID("v1").Sign(privA)
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: emissor aceito", auth, []ValidationOption{WithIssuer("auth.exemplo.com")}, nil},
+		{"Sucesso: lista com vários emissores", billing, []ValidationOption{WithIssuer("auth.exemplo.com", "billing.exemplo.com")}, nil},
+		{"Sucesso: sem restrição de emissor", billing, nil, nil},
+		{"Falha: emissor fora da lista", billing, []ValidationOption{WithIssuer("auth.exemplo.com")}, ErrIssuerMismatch},
+		{"Falha: token sem emissor", noIssuer, []ValidationOption{WithIssuer("auth.exemplo.com")}, ErrIssuerMismatch},
+		{"Falha: kid resolvido no escopo do emissor declarado", forged, []ValidationOption{WithIssuer("auth.exemplo.com")}, ErrInvalidSignature},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := ParseWithResolver(context.Background(), tc.token, resolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+			if err == nil && payload.Iss == "" {
+				t.Error("iss ausente no payload validado")
+			}
+		})
+	}
+
+	// Emissores não aceitos são rejeitados sem consultar o resolver
+	resolverCalls = 0
+	_, _ = ParseWithResolver(context.Background(), billing, resolver, WithIssuer("auth.exemplo.com"))
+	if resolverCalls != 0 {
+		t.Errorf("resolver não deveria ser consultado para emissor rejeitado, chamadas: %d", resolverCalls)
+	}
+}
+
+// Testa que o KeyRequest carrega o emissor para resolvers genéricos
+func TestKeyRequest_Issuer(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	tokenBytes, _ := NewPayload().WithIssuer("auth.exemplo.com").WithKeyID("v1").Sign(priv)
+	var got KeyRequest
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		got = req
+		return VerificationKey{Algorithm: AlgEd25519, Key: pub}, nil
+	})
+	if _, err := ParseWithResolver(context.Background(), tokenBytes, resolver); err != nil {
+		t.Fatalf("erro ao validar: %v", err)
+	}
+	if got.Issuer != "auth.exemplo.com" || got.KeyID != "v1" || got.Algorithm != AlgEd25519 {
+		t.Errorf("KeyRequest inesperado: %+v", got)
+	}
+}
@@ -0,0 +1,73 @@
+package signet
+
+import (
+	"fmt"
+	"time"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// WithMaxLifetime limita a validade dos tokens emitidos por este builder: Build falha com
+// ErrLifetimeTooLong se exp - iat exceder maxLifetime. Protege o emissor contra erros de
+// configuração que produziriam tokens de longa duração.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().
+//	    WithMaxLifetime(time.Hour).
+//	    WithExpiration(time.Now().Add(ttlConfigurado).Unix())
+func (b *PayloadBuilder) WithMaxLifetime(maxLifetime time.Duration) *PayloadBuilder {
+	b.maxLifetime = maxLifetime
+	return b
+}
+
+// WithMaxAge rejeita com ErrTokenTooOld tokens emitidos há mais de maxAge (now - iat > maxAge),
+// independentemente do exp escolhido pelo emissor. A tolerância de WithLeeway é aplicada.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithMaxAge(30*time.Minute))
+func WithMaxAge(maxAge time.Duration) ValidationOption {
+	return func(c *validationConfig) {
+		c.maxAge = maxAge
+	}
+}
+
+// WithMaxLifetime rejeita com ErrLifetimeTooLong tokens cuja validade declarada excede
+// maxLifetime (exp - iat > maxLifetime), protegendo o validador de emissores comprometidos
+// ou mal configurados que emitam tokens de longa duração.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithMaxLifetime(time.Hour))
+func WithMaxLifetime(maxLifetime time.Duration) ValidationOption {
+	return func(c *validationConfig) {
+		c.maxLifetime = maxLifetime
+	}
+}
+
+// lifetime retorna a validade declarada do payload (exp - iat).
+func lifetime(payload *signetv1.SignetPayload) time.Duration {
+	return time.Unix(payload.Exp, 0).Sub(time.Unix(payload.Iat, 0))
+}
+
+// checkMaxLifetime aplica o limite de WithMaxLifetime.
+func checkMaxLifetime(payload *signetv1.SignetPayload, config *validationConfig) (string, error) {
+	if d := lifetime(payload); d > config.maxLifetime {
+		return ReasonLifetimeTooLong, fmt.Errorf("validade de %v excede %v: %w", d, config.maxLifetime, ErrLifetimeTooLong)
+	}
+	return "", nil
+}
+
+// checkMaxAge aplica o limite de WithMaxAge, com a tolerância de WithLeeway.
+func checkMaxAge(payload *signetv1.SignetPayload, config *validationConfig, now time.Time) (string, error) {
+	if age := tokenAge(payload, config, now); age > config.maxAge {
+		return ReasonTokenTooOld, fmt.Errorf("idade de %v excede %v: %w", age, config.maxAge, ErrTokenTooOld)
+	}
+	return "", nil
+}
+
+// tokenAge retorna a idade do token (now - leeway - iat), truncada em segundos.
+func tokenAge(payload *signetv1.SignetPayload, config *validationConfig, now time.Time) time.Duration {
+	return now.Add(-config.leeway).Sub(time.Unix(payload.Iat, 0)).Truncate(time.Second)
+}
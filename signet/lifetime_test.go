@@ -0,0 +1,74 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucas-de-lima/signet-go/signet/signettest"
+)
+
+// Testa o limite de validade do emissor no Build
+func TestBuild_MaxLifetime(t *testing.T) {
+	iat := time.Now().Unix()
+	testCases := []struct {
+		name          string
+		exp           int64
+		expectedError error
+	}{
+		{"Sucesso: validade abaixo do limite", iat + 30*60, nil},
+		{"Sucesso: validade igual ao limite", iat + 60*60, nil},
+		{"Falha: validade acima do limite", iat + 365*24*60*60, ErrLifetimeTooLong},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPayload().WithMaxLifetime(time.Hour).WithIssuedAt(iat).WithExpiration(tc.exp).Build()
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+// Testa a idade máxima e a validade máxima aplicadas pelo validador
+func TestParse_MaxAgeAndMaxLifetime(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	start := time.Unix(1700000000, 0)
+	iat := start.Unix()
+	yearLong, _ := NewPayload().WithIssuedAt(iat).WithExpiration(start.AddDate(1, 0, 0).Unix()).Sign(priv)
+	hourLong, _ := NewPayload().WithIssuedAt(iat).WithExpiration(iat + 60*60).Sign(priv)
+
+	recorder := &countingRecorder{}
+	testCases := []struct {
+		name          string
+		token         []byte
+		offset        time.Duration
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: sem limites, validade de um ano", yearLong, 0, nil, nil},
+		{"Falha: validade acima do máximo", yearLong, 0, []ValidationOption{WithMaxLifetime(24 * time.Hour), WithMetricsRecorder(recorder)}, ErrLifetimeTooLong},
+		{"Sucesso: validade dentro do máximo", hourLong, 0, []ValidationOption{WithMaxLifetime(time.Hour)}, nil},
+		{"Sucesso: idade dentro do máximo", hourLong, 20 * time.Minute, []ValidationOption{WithMaxAge(30 * time.Minute)}, nil},
+		{"Falha: idade acima do máximo", hourLong, 40 * time.Minute, []ValidationOption{WithMaxAge(30 * time.Minute), WithMetricsRecorder(recorder)}, ErrTokenTooOld},
+		{"Falha: token de longa duração ainda válido, mas antigo", yearLong, 48 * time.Hour, []ValidationOption{WithMaxAge(24 * time.Hour)}, ErrTokenTooOld},
+		{"Sucesso: idade dentro da tolerância de relógio", hourLong, 31 * time.Minute, []ValidationOption{WithMaxAge(30 * time.Minute), WithLeeway(time.Minute)}, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := append([]ValidationOption{WithClock(signettest.NewFakeClock(start.Add(tc.offset)))}, tc.options...)
+			_, err := Parse(context.Background(), tc.token, keyResolver, options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+	if recorder.reasons[ReasonLifetimeTooLong] != 1 || recorder.reasons[ReasonTokenTooOld] != 1 {
+		t.Errorf("métricas inesperadas: %v", recorder.reasons)
+	}
+}
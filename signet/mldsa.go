@@ -0,0 +1,59 @@
+package signet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+)
+
+// AlgMLDSA65 identifica assinaturas pós-quânticas ML-DSA-65 (FIPS 204).
+const AlgMLDSA65 = core.AlgMLDSA65
+
+// Tamanhos, em bytes, das chaves públicas e assinaturas ML-DSA-65.
+// Um token ML-DSA-65 é cerca de 3,2 KiB maior que o equivalente Ed25519.
+const (
+	MLDSA65PublicKeySize = core.MLDSA65PublicKeySize
+	MLDSA65SignatureSize = core.MLDSA65SignatureSize
+)
+
+// SignMLDSA65 assina o payload com ML-DSA-65.
+//
+// Exemplo:
+//
+//	_, privateKey, _ := mldsa65.GenerateKey(nil)
+//	tokenBytes, err := signet.NewPayload().WithKeyID("pq-v1").SignMLDSA65(privateKey)
+func (b *PayloadBuilder) SignMLDSA65(privateKey *mldsa65.PrivateKey) ([]byte, error) {
+	if privateKey == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	return b.SignWithAlgorithm(AlgMLDSA65, privateKey)
+}
+
+// MLDSA65KeyResolverFunc é o análogo de KeyResolverFunc para chaves ML-DSA-65.
+// A função retorna a chave pública em sua codificação compacta (MLDSA65PublicKeySize bytes),
+// formato em que ela normalmente é armazenada e distribuída. O tamanho é validado antes da
+// verificação e a chave é vinculada ao algoritmo ML-DSA-65.
+//
+// Exemplo:
+//
+//	resolver := signet.MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) {
+//	    return keyStore.Get(ctx, kid)
+//	})
+//	payload, err := signet.ParseWithResolver(ctx, tokenBytes, resolver)
+type MLDSA65KeyResolverFunc func(ctx context.Context, kid string) ([]byte, error)
+
+// ResolveKey implementa KeyResolver.
+func (f MLDSA65KeyResolverFunc) ResolveKey(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+	encoded, err := f(ctx, req.KeyID)
+	if err != nil {
+		return VerificationKey{}, err
+	}
+	pub, err := core.ParseMLDSA65PublicKey(encoded)
+	if err != nil {
+		return VerificationKey{}, fmt.Errorf("chave ML-DSA-65 com %d bytes (esperado %d): %w", len(encoded), MLDSA65PublicKeySize, ErrInvalidPublicKey)
+	}
+	return VerificationKey{Algorithm: AlgMLDSA65, Key: pub}, nil
+}
@@ -0,0 +1,87 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+)
+
+// Testa assinatura e validação round-trip com ML-DSA-65 (builder, crypto.Signer e resolver por bytes)
+func TestSignMLDSA65_RoundTrip(t *testing.T) {
+	pub, priv, _ := mldsa65.GenerateKey(nil)
+	resolver := MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) {
+		return pub.Bytes(), nil
+	})
+	direct, err := NewPayload().WithSubject("user-pq").SignMLDSA65(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	viaSigner, err := NewPayload().WithSubject("user-pq").SignWith(context.Background(), priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar via crypto.Signer: %v", err)
+	}
+	for _, tokenBytes := range [][]byte{direct, viaSigner} {
+		payload, err := ParseWithResolver(context.Background(), tokenBytes, resolver)
+		if err != nil {
+			t.Fatalf("erro ao validar token: %v", err)
+		}
+		if payload.Sub != "user-pq" {
+			t.Error("payload retornado não bate com o original")
+		}
+	}
+}
+
+// Testa a rejeição de chaves truncadas e de confusão com Ed25519
+func TestParse_MLDSA65KeyErrors(t *testing.T) {
+	pub, priv, _ := mldsa65.GenerateKey(nil)
+	edPub, _, _ := ed25519.GenerateKey(nil)
+	tokenBytes, _ := NewPayload().SignMLDSA65(priv)
+
+	testCases := []struct {
+		name          string
+		resolver      KeyResolver
+		expectedError error
+	}{
+		{"Falha: chave truncada", MLDSA65KeyResolverFunc(func(ctx context.Context, kid string) ([]byte, error) {
+			return pub.Bytes()[:32], nil
+		}), ErrInvalidPublicKey},
+		{"Falha: chave Ed25519 para token ML-DSA-65", KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+			return edPub, nil
+		}), ErrAlgorithmMismatch},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseWithResolver(context.Background(), tokenBytes, tc.resolver)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+// Mede o impacto do ML-DSA-65 no tamanho do token em relação ao Ed25519
+func TestTokenSize_MLDSA65VsEd25519(t *testing.T) {
+	_, edPriv, _ := ed25519.GenerateKey(nil)
+	_, pqPriv, _ := mldsa65.GenerateKey(nil)
+	builder := NewPayload().WithSubject("user-123").WithAudience("api-backend").WithRole("admin").WithKeyID("v1")
+	edToken, err := builder.Sign(edPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	pqToken, err := builder.SignMLDSA65(pqPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	t.Logf("Ed25519: %d bytes | ML-DSA-65: %d bytes | aumento: %d bytes (%.1fx)",
+		len(edToken), len(pqToken), len(pqToken)-len(edToken), float64(len(pqToken))/float64(len(edToken)))
+	if growth := len(pqToken) - len(edToken); growth < MLDSA65SignatureSize-ed25519.SignatureSize {
+		t.Errorf("aumento inesperadamente pequeno: %d bytes", growth)
+	}
+	if len(pqToken) > MLDSA65SignatureSize+512 {
+		t.Errorf("token ML-DSA-65 com overhead inesperado: %d bytes", len(pqToken))
+	}
+}
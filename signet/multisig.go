@@ -0,0 +1,188 @@
+package signet
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// MultiSignatureBuilder adiciona co-assinaturas a um token já assinado pelo emissor principal.
+// Cada co-assinante (ex: serviço de aprovação, serviço de identidade) assina os mesmos bytes
+// do payload com sua própria chave, identificada por um kid distinto.
+// O token resultante é validado com RequireSignatures.
+//
+// Exemplo:
+//
+//	tokenBytes, _ := signet.NewPayload().WithKeyID("identity-v1").Sign(identityKey)
+//	ms, err := signet.NewMultiSignature(tokenBytes)
+//	if err != nil {
+//	    return err
+//	}
+//	if err := ms.AddSignature(ctx, "approval-v1", approvalKey); err != nil {
+//	    return err
+//	}
+//	tokenBytes, err = ms.Token()
+type MultiSignatureBuilder struct {
+	token          *signetv1.SignetToken
+	kid            string
+	signingContext string
+}
+
+// NewMultiSignature inicia a co-assinatura de um token serializado.
+// A assinatura principal do token pertence ao kid do payload.
+func NewMultiSignature(tokenBytes []byte) (*MultiSignatureBuilder, error) {
+	var token signetv1.SignetToken
+	if err := proto.Unmarshal(tokenBytes, &token); err != nil {
+		return nil, fmt.Errorf("falha ao deserializar SignetToken: %w", ErrInvalidPayload)
+	}
+	var payload signetv1.SignetPayload
+	if err := proto.Unmarshal(token.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("falha ao deserializar SignetPayload: %w", ErrInvalidPayload)
+	}
+	return &MultiSignatureBuilder{token: &token, kid: payload.Kid}, nil
+}
+
+// WithSigningContext define o contexto de assinatura usado pelas co-assinaturas.
+// Deve ser o mesmo usado pelo emissor principal (ver PayloadBuilder.WithSigningContext).
+func (m *MultiSignatureBuilder) WithSigningContext(signingContext string) *MultiSignatureBuilder {
+	m.signingContext = signingContext
+	return m
+}
+
+// AddSignature assina o payload com o signer fornecido e adiciona a assinatura ao token,
+// associada ao kid. O algoritmo é selecionado pelo tipo de signer.Public().
+// Um kid vazio corresponde ao kid do payload; kids repetidos são recusados com ErrDuplicateSignature.
+func (m *MultiSignatureBuilder) AddSignature(ctx context.Context, kid string, signer crypto.Signer) error {
+	if kid == "" {
+		kid = m.kid
+	}
+	if m.hasSignature(kid) {
+		return fmt.Errorf("kid '%s': %w", kid, ErrDuplicateSignature)
+	}
+	alg, signFn, err := signerSignFunc(ctx, signer)
+	if err != nil {
+		return err
+	}
+	message, err := core.SigningMessage(m.token.Version, m.signingContext, m.token.Payload)
+	if err != nil {
+		return fmt.Errorf("contexto de assinatura: %w", ErrInvalidSigningContext)
+	}
+	signature, err := signFn(message)
+	if err != nil {
+		return fmt.Errorf("falha ao assinar payload no núcleo criptográfico: %w", err)
+	}
+	m.token.Signatures = append(m.token.Signatures, &signetv1.SignetSignature{Alg: alg, Signature: signature, Kid: kid})
+	return nil
+}
+
+// Token serializa o token com todas as assinaturas adicionadas até o momento.
+func (m *MultiSignatureBuilder) Token() ([]byte, error) {
+	tokenBytes, err := canonicalMarshal.Marshal(m.token)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao serializar SignetToken para protobuf: %w", err)
+	}
+	return tokenBytes, nil
+}
+
+// hasSignature indica se o token já possui uma assinatura associada ao kid.
+func (m *MultiSignatureBuilder) hasSignature(kid string) bool {
+	if len(m.token.Signature) > 0 && kid == m.kid {
+		return true
+	}
+	for _, sig := range m.token.Signatures {
+		if signatureKeyID(sig, m.kid) == kid {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireSignatures exige que o token possua pelo menos k assinaturas válidas de chaves distintas.
+// Se trustedKids for informado, apenas assinaturas desses kids são contadas; caso contrário,
+// conta qualquer kid cuja chave seja resolvida pelo KeyResolver.
+// A assinatura principal conta para o kid do payload. Kids que resolvem para a mesma chave
+// pública contam uma única vez. Assinaturas inválidas, de kids não confiáveis ou HMAC são
+// ignoradas. Substitui a política híbrida (ver WithHybridPolicy). Um limiar k menor que 1
+// é rejeitado por NewValidator com ErrInvalidConfig e faz o Parse rejeitar todos os tokens.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver,
+//	    signet.RequireSignatures(2, "identity-v1", "approval-v1", "audit-v1"))
+func RequireSignatures(k int, trustedKids ...string) ValidationOption {
+	return func(c *validationConfig) {
+		c.thresholdSet = true
+		c.requiredSignatures = k
+		c.trustedKids = nil
+		if len(trustedKids) > 0 {
+			c.trustedKids = make(map[string]struct{}, len(trustedKids))
+			for _, kid := range trustedKids {
+				c.trustedKids[kid] = struct{}{}
+			}
+		}
+	}
+}
+
+// verifyThreshold conta as assinaturas válidas de chaves distintas e kids confiáveis,
+// falhando com ErrInsufficientSignatures se o limiar configurado não for atingido.
+func verifyThreshold(ctx context.Context, token *signetv1.SignetToken, message []byte, ref KeyRequest, keyResolver KeyResolver, config *validationConfig) (string, error) {
+	if config.requiredSignatures < 1 {
+		return ReasonInsufficientSignatures, fmt.Errorf("limiar de %d assinaturas: %w", config.requiredSignatures, ErrInvalidConfig)
+	}
+	candidates := make([]*signetv1.SignetSignature, 0, len(token.Signatures)+1)
+	candidates = append(candidates, &signetv1.SignetSignature{Alg: token.Alg, Signature: token.Signature, Kid: ref.KeyID})
+	candidates = append(candidates, token.Signatures...)
+
+	seenKids := make(map[string]struct{}, len(candidates))
+	validKeys := make(map[string]struct{}, config.requiredSignatures)
+	for _, sig := range candidates {
+		signerKid := signatureKeyID(sig, ref.KeyID)
+		if _, seen := seenKids[signerKid]; seen {
+			continue
+		}
+		if config.trustedKids != nil {
+			if _, trusted := config.trustedKids[signerKid]; !trusted {
+				continue
+			}
+		}
+		signerRef := ref
+		signerRef.KeyID = signerKid
+		key, _, err := verifySignatureKey(ctx, keyResolver, signerRef, tokenAlgorithm(sig.Alg), message, sig.Signature)
+		if err != nil {
+			continue
+		}
+		keyID, err := publicKeyID(key)
+		if err != nil {
+			return ReasonUnsupportedAlgorithm, err
+		}
+		seenKids[signerKid] = struct{}{}
+		validKeys[keyID] = struct{}{}
+		if len(validKeys) >= config.requiredSignatures {
+			return "", nil
+		}
+	}
+	return ReasonInsufficientSignatures, fmt.Errorf("%d de %d assinaturas válidas exigidas: %w", len(validKeys), config.requiredSignatures, ErrInsufficientSignatures)
+}
+
+// publicKeyID retorna uma identificação da chave pública resolvida, independente do kid:
+// o algoritmo e a codificação canônica da chave (ver PublicKeyEncoder).
+func publicKeyID(key VerificationKey) (string, error) {
+	encoded, err := core.DefaultRegistry().EncodePublicKey(key.Algorithm, key.Key)
+	if err != nil {
+		return "", fmt.Errorf("chave do algoritmo '%s' sem codificação canônica: %w: %w", key.Algorithm, ErrUnsupportedAlgorithm, err)
+	}
+	return key.Algorithm + "\x00" + string(encoded), nil
+}
+
+// signatureKeyID retorna o kid de uma assinatura adicional; vazio corresponde ao kid do payload.
+func signatureKeyID(sig *signetv1.SignetSignature, payloadKid string) string {
+	if sig.Kid == "" {
+		return payloadKid
+	}
+	return sig.Kid
+}
@@ -0,0 +1,213 @@
+package signet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Testa a verificação k-de-n de tokens co-assinados por emissores independentes
+func TestParse_RequireSignatures(t *testing.T) {
+	ctx := context.Background()
+	identityPub, identityPriv, _ := ed25519.GenerateKey(nil)
+	approvalPriv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	auditPub, auditPriv, _ := ed25519.GenerateKey(nil)
+	_, roguePriv, _ := ed25519.GenerateKey(nil)
+	keys := map[string]VerificationKey{
+		"identity-v1": {Algorithm: AlgEd25519, Key: identityPub},
+		"approval-v1": {Algorithm: AlgES256, Key: &approvalPriv.PublicKey},
+		"audit-v1":    {Algorithm: AlgEd25519, Key: auditPub},
+		// A chave de identidade também registrada sob outros kids
+		"":               {Algorithm: AlgEd25519, Key: identityPub},
+		"identity-alias": {Algorithm: AlgEd25519, Key: identityPub},
+	}
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		key, ok := keys[req.KeyID]
+		if !ok {
+			return VerificationKey{}, ErrUnknownKeyID
+		}
+		return key, nil
+	})
+
+	single, err := NewPayload().WithKeyID("identity-v1").Sign(identityPriv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	ms, err := NewMultiSignature(single)
+	if err != nil {
+		t.Fatalf("erro ao iniciar co-assinatura: %v", err)
+	}
+	if err := ms.AddSignature(ctx, "approval-v1", approvalPriv); err != nil {
+		t.Fatalf("erro ao co-assinar: %v", err)
+	}
+	double, _ := ms.Token()
+	if err := ms.AddSignature(ctx, "rogue-v1", roguePriv); err != nil {
+		t.Fatalf("erro ao co-assinar: %v", err)
+	}
+	withRogue, _ := ms.Token()
+	badApproval := corruptToken(t, double, func(tok *signetv1.SignetToken) { tok.Signatures[0].Signature[0] ^= 0xFF })
+	// Assinatura válida do emissor de auditoria, mas rotulada com o kid da aprovação
+	relabeled := corruptToken(t, single, func(tok *signetv1.SignetToken) {
+		message, _ := core.SigningMessage(tok.Version, "", tok.Payload)
+		sig, _ := auditPriv.Sign(nil, message, &ed25519.Options{})
+		tok.Signatures = append(tok.Signatures, &signetv1.SignetSignature{Alg: AlgEd25519, Signature: sig, Kid: "approval-v1"})
+	})
+	// A mesma chave sob dois kids (o kid vazio do payload e um alias) não conta duas vezes
+	unkeyed, _ := NewPayload().Sign(identityPriv)
+	aliasMS, _ := NewMultiSignature(unkeyed)
+	if err := aliasMS.AddSignature(ctx, "identity-alias", identityPriv); err != nil {
+		t.Fatalf("erro ao co-assinar: %v", err)
+	}
+	sameKey, _ := aliasMS.Token()
+	// A mesma assinatura repetida não conta duas vezes
+	repeated := corruptToken(t, single, func(tok *signetv1.SignetToken) {
+		tok.Signatures = append(tok.Signatures, &signetv1.SignetSignature{Alg: tok.Alg, Signature: tok.Signature, Kid: "identity-v1"})
+	})
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		option        ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: 2-de-2", double, RequireSignatures(2, "identity-v1", "approval-v1"), nil},
+		{"Sucesso: 2-de-3 com kid desconhecido ignorado", withRogue, RequireSignatures(2, "identity-v1", "approval-v1", "audit-v1"), nil},
+		{"Sucesso: 1-de-n apenas com a co-assinatura", double, RequireSignatures(1, "approval-v1"), nil},
+		{"Sucesso: qualquer kid resolvível", withRogue, RequireSignatures(2), nil},
+		{"Falha: apenas uma assinatura", single, RequireSignatures(2, "identity-v1", "approval-v1"), ErrInsufficientSignatures},
+		{"Falha: kid não confiável não conta", double, RequireSignatures(2, "identity-v1", "audit-v1"), ErrInsufficientSignatures},
+		{"Falha: kid sem chave não conta", withRogue, RequireSignatures(3), ErrInsufficientSignatures},
+		{"Falha: co-assinatura corrompida", badApproval, RequireSignatures(2, "identity-v1", "approval-v1"), ErrInsufficientSignatures},
+		{"Falha: assinatura com kid trocado", relabeled, RequireSignatures(2), ErrInsufficientSignatures},
+		{"Falha: kid repetido", repeated, RequireSignatures(2), ErrInsufficientSignatures},
+		{"Falha: mesma chave sob kids distintos", sameKey, RequireSignatures(2), ErrInsufficientSignatures},
+		{"Sucesso: mesma chave sob kids distintos conta uma vez", sameKey, RequireSignatures(1), nil},
+		{"Falha: limiar zero", double, RequireSignatures(0), ErrInvalidConfig},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseWithResolver(ctx, tc.token, resolver, tc.option)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Sem RequireSignatures, apenas a assinatura principal é verificada
+	if _, err := ParseWithResolver(ctx, badApproval, resolver); err != nil {
+		t.Errorf("validador sem limiar deveria ignorar co-assinaturas: %v", err)
+	}
+}
+
+// Testa a co-assinatura com contexto de assinatura e o uso do KeyResolverFunc existente
+func TestMultiSignature_WithSigningContext(t *testing.T) {
+	ctx := context.Background()
+	pubA, privA, _ := ed25519.GenerateKey(nil)
+	pubB, privB, _ := ed25519.GenerateKey(nil)
+	keys := map[string]ed25519.PublicKey{"a": pubA, "b": pubB}
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		key, ok := keys[kid]
+		if !ok {
+			return nil, ErrUnknownKeyID
+		}
+		return key, nil
+	}
+
+	tokenBytes, _ := NewPayload().WithKeyID("a").WithSigningContext("aprovacoes").Sign(privA)
+	ms, _ := NewMultiSignature(tokenBytes)
+	if err := ms.WithSigningContext("aprovacoes").AddSignature(ctx, "b", privB); err != nil {
+		t.Fatalf("erro ao co-assinar: %v", err)
+	}
+	tokenBytes, _ = ms.Token()
+
+	if _, err := Parse(ctx, tokenBytes, keyResolver, WithSigningContext("aprovacoes"), RequireSignatures(2, "a", "b")); err != nil {
+		t.Errorf("token co-assinado deveria ser aceito: %v", err)
+	}
+	_, err := Parse(ctx, tokenBytes, keyResolver, RequireSignatures(2, "a", "b"))
+	if !errors.Is(err, ErrInsufficientSignatures) {
+		t.Errorf("esperava ErrInsufficientSignatures sem o contexto, obteve: %v", err)
+	}
+}
+
+// Testa os erros do builder de co-assinaturas
+func TestMultiSignature_BuilderErrors(t *testing.T) {
+	ctx := context.Background()
+	_, priv, _ := ed25519.GenerateKey(nil)
+	tokenBytes, _ := NewPayload().WithKeyID("a").Sign(priv)
+
+	if _, err := NewMultiSignature([]byte{0xFF}); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("esperava ErrInvalidPayload, obteve: %v", err)
+	}
+	ms, _ := NewMultiSignature(tokenBytes)
+	if err := ms.AddSignature(ctx, "a", priv); !errors.Is(err, ErrDuplicateSignature) {
+		t.Errorf("esperava ErrDuplicateSignature para o kid principal, obteve: %v", err)
+	}
+	if err := ms.AddSignature(ctx, "", priv); !errors.Is(err, ErrDuplicateSignature) {
+		t.Errorf("esperava ErrDuplicateSignature para kid vazio, obteve: %v", err)
+	}
+	if err := ms.AddSignature(ctx, "b", priv); err != nil {
+		t.Fatalf("erro ao co-assinar: %v", err)
+	}
+	if err := ms.AddSignature(ctx, "b", priv); !errors.Is(err, ErrDuplicateSignature) {
+		t.Errorf("esperava ErrDuplicateSignature, obteve: %v", err)
+	}
+	if err := ms.AddSignature(ctx, "c", nil); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("esperava ErrInvalidPrivateKey, obteve: %v", err)
+	}
+}
+
+// Testa o limiar com chaves de um algoritmo registrado via RegisterAlgorithm
+func TestParse_RequireSignaturesCustomAlgorithm(t *testing.T) {
+	if err := RegisterAlgorithm(testAlgorithm{}); err != nil && !errors.Is(err, core.ErrAlgorithmAlreadyRegistered) {
+		t.Fatalf("erro ao registrar algoritmo: %v", err)
+	}
+	secret, otherSecret := []byte("segredo-de-teste"), []byte("outro-segredo")
+	keys := map[string]VerificationKey{
+		"custom":       {Algorithm: "test-alg", Key: secret},
+		"custom-alias": {Algorithm: "test-alg", Key: append([]byte(nil), secret...)},
+		"custom-other": {Algorithm: "test-alg", Key: otherSecret},
+	}
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		key, ok := keys[req.KeyID]
+		if !ok {
+			return VerificationKey{}, ErrUnknownKeyID
+		}
+		return key, nil
+	})
+	single, err := NewPayload().WithKeyID("custom").SignWithAlgorithm("test-alg", secret)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	cosign := func(kid string, key []byte) []byte {
+		return corruptToken(t, single, func(tok *signetv1.SignetToken) {
+			tok.Signatures = append(tok.Signatures, &signetv1.SignetSignature{Alg: "test-alg", Signature: key, Kid: kid})
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		option        ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: 1-de-n", single, RequireSignatures(1), nil},
+		{"Sucesso: 2-de-n com chaves distintas", cosign("custom-other", otherSecret), RequireSignatures(2), nil},
+		{"Falha: mesma chave sob kids distintos", cosign("custom-alias", secret), RequireSignatures(2), ErrInsufficientSignatures},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseWithResolver(context.Background(), tc.token, resolver, tc.option)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package signet
 
 import (
 	"context"
+	"crypto"
 	"crypto/ed25519"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"time"
@@ -19,7 +21,8 @@ var (
 	ErrTokenExpired = errors.New("token expirado")
 	// ErrTokenNotYetValid indica que o campo iat do token está no futuro.
 	ErrTokenNotYetValid = errors.New("token com iat no futuro")
-	// ErrInvalidSignature indica que a assinatura Ed25519 do token é inválida.
+	// ErrInvalidSignature indica que a assinatura do token (ou o MAC HMAC) não confere para o
+	// algoritmo declarado.
 	ErrInvalidSignature = errors.New("assinatura inválida")
 	// ErrInvalidPayload indica que o payload do token é inválido ou malformado.
 	ErrInvalidPayload = errors.New("payload inválido")
@@ -29,14 +32,56 @@ var (
 	ErrInvalidPublicKey = errors.New("chave pública inválida")
 	// ErrInvalidExpIat indica que exp <= iat, o que viola a regra temporal.
 	ErrInvalidExpIat = errors.New("exp deve ser maior que iat")
+	// ErrInvalidNotBefore indica que nbf viola a regra temporal iat <= nbf < exp.
+	ErrInvalidNotBefore = errors.New("nbf deve satisfazer iat <= nbf < exp")
+	// ErrTokenNotBefore indica que o token ainda não é válido (nbf no futuro).
+	ErrTokenNotBefore = errors.New("token ainda não é válido (nbf no futuro)")
+	// ErrTokenTooOld indica que o token foi emitido há mais tempo que a idade máxima aceita (WithMaxAge).
+	ErrTokenTooOld = errors.New("token excede a idade máxima aceita")
+	// ErrLifetimeTooLong indica que a validade do token (exp - iat) excede o máximo permitido (WithMaxLifetime).
+	ErrLifetimeTooLong = errors.New("validade do token excede o máximo permitido")
+	// ErrIssuerMismatch indica que o emissor (iss) do token não está entre os emissores aceitos.
+	ErrIssuerMismatch = errors.New("emissor do token não está entre os emissores aceitos")
 	// ErrAudienceMismatch indica que a audiência do token não corresponde à esperada.
 	ErrAudienceMismatch = errors.New("audiência do token não corresponde à esperada")
 	// ErrMissingRequiredRole indica que o payload não possui o(s) papel(is) requerido(s).
 	ErrMissingRequiredRole = errors.New("payload não possui o(s) papel(is) requerido(s)")
 	// ErrTokenRevoked indica que o token foi revogado (sid presente na lista de revogação).
 	ErrTokenRevoked = errors.New("token revogado (sid presente na lista de revogação)")
+	// ErrExtensionMismatch indica que a extensão do payload está ausente ou não é do tipo esperado.
+	ErrExtensionMismatch = errors.New("extensão do payload ausente ou de tipo diferente do esperado")
+	// ErrTokenReplayed indica que o jti do token já foi utilizado (tentativa de replay).
+	ErrTokenReplayed = errors.New("token já utilizado (jti repetido)")
+	// ErrUnsupportedVersion indica que a versão do formato do token não é suportada ou não é aceita.
+	ErrUnsupportedVersion = errors.New("versão do formato do token não suportada")
+	// ErrInvalidConfig indica uma configuração de validação inválida ou contraditória (ver NewValidator).
+	ErrInvalidConfig = errors.New("configuração de validação inválida")
+	// ErrNonCanonicalEncoding indica que o envelope ou o payload do token não está na codificação protobuf canônica.
+	ErrNonCanonicalEncoding = errors.New("token fora da codificação protobuf canônica")
+	// ErrInvalidTokenEncoding indica um token textual sem o prefixo esperado ou com base64url não canônico.
+	ErrInvalidTokenEncoding = errors.New("codificação textual do token inválida")
+	// ErrDecryptionFailed indica que o token selado não pôde ser decifrado (chave incorreta ou adulteração).
+	ErrDecryptionFailed = errors.New("falha ao decifrar o token selado")
 	// ErrUnknownKeyID indica que o kid do token não corresponde a nenhuma chave pública conhecida.
 	ErrUnknownKeyID = errors.New("kid do token não corresponde a nenhuma chave pública conhecida")
+	// ErrUnsupportedAlgorithm indica que o algoritmo declarado no token não está registrado.
+	ErrUnsupportedAlgorithm = errors.New("algoritmo de assinatura não suportado")
+	// ErrAlgorithmMismatch indica que a chave resolvida foi registrada para um algoritmo diferente do declarado no token.
+	ErrAlgorithmMismatch = errors.New("algoritmo do token não corresponde ao algoritmo da chave")
+	// ErrSignerFailed indica que o assinador externo (crypto.Signer, KMS, HSM) falhou ao produzir a assinatura.
+	ErrSignerFailed = errors.New("falha no assinador externo")
+	// ErrHybridSignatureRequired indica que a política híbrida exige uma assinatura pós-quântica ausente no token.
+	ErrHybridSignatureRequired = errors.New("token não possui a assinatura exigida pela política híbrida")
+	// ErrHMACNotAllowed indica um token HMAC recebido sem que o validador tenha habilitado o perfil simétrico.
+	ErrHMACNotAllowed = errors.New("tokens HMAC não são aceitos sem habilitação explícita")
+	// ErrWeakSecret indica que o segredo HMAC é menor que o tamanho mínimo exigido.
+	ErrWeakSecret = errors.New("segredo HMAC com tamanho inferior ao mínimo exigido")
+	// ErrInvalidSigningContext indica um contexto de assinatura maior que o tamanho máximo (255 bytes).
+	ErrInvalidSigningContext = errors.New("contexto de assinatura excede o tamanho máximo")
+	// ErrInsufficientSignatures indica que o token não atingiu o número mínimo de assinaturas válidas exigido.
+	ErrInsufficientSignatures = errors.New("token não possui o número mínimo de assinaturas válidas")
+	// ErrDuplicateSignature indica uma tentativa de adicionar uma segunda assinatura para o mesmo kid.
+	ErrDuplicateSignature = errors.New("token já possui assinatura para este kid")
 )
 
 // Razões padronizadas para métricas de validação
@@ -47,16 +92,48 @@ const (
 	ReasonInvalidSignature = "invalid_signature"
 	// ReasonTokenExpired indica que o token expirou.
 	ReasonTokenExpired = "token_expired"
+	// ReasonTokenTooOld indica token emitido há mais tempo que a idade máxima aceita.
+	ReasonTokenTooOld = "token_too_old"
+	// ReasonLifetimeTooLong indica token com validade acima do máximo permitido.
+	ReasonLifetimeTooLong = "lifetime_too_long"
+	// ReasonIssuerMismatch indica que o emissor não é aceito.
+	ReasonIssuerMismatch = "issuer_mismatch"
 	// ReasonAudienceMismatch indica que a audiência não corresponde.
 	ReasonAudienceMismatch = "audience_mismatch"
 	// ReasonInvalidPayload indica que o payload é inválido ou malformado.
 	ReasonInvalidPayload = "invalid_payload"
 	// ReasonTokenNotYetValid indica que o iat do token está no futuro.
 	ReasonTokenNotYetValid = "token_not_yet_valid"
+	// ReasonTokenNotBefore indica que o nbf do token está no futuro.
+	ReasonTokenNotBefore = "token_not_before"
 	// ReasonMissingRequiredRole indica que um papel obrigatório está ausente.
 	ReasonMissingRequiredRole = "missing_required_role"
 	// ReasonTokenRevoked indica que o token foi revogado.
 	ReasonTokenRevoked = "token_revoked"
+	// ReasonExtensionMismatch indica que a extensão do payload não é do tipo esperado.
+	ReasonExtensionMismatch = "extension_mismatch"
+	// ReasonTokenReplayed indica que o jti do token já foi utilizado.
+	ReasonTokenReplayed = "token_replayed"
+	// ReasonReplayStoreError indica falha ao consultar o armazenamento de jtis utilizados.
+	ReasonReplayStoreError = "replay_store_error"
+	// ReasonUnsupportedAlgorithm indica que o algoritmo declarado no token não está registrado.
+	ReasonUnsupportedAlgorithm = "unsupported_algorithm"
+	// ReasonNonCanonicalEncoding indica token rejeitado pela decodificação estrita.
+	ReasonNonCanonicalEncoding = "non_canonical_encoding"
+	// ReasonInvalidTokenEncoding indica falha ao decodificar um token textual.
+	ReasonInvalidTokenEncoding = "invalid_token_encoding"
+	// ReasonDecryptionFailed indica falha ao abrir um token selado.
+	ReasonDecryptionFailed = "decryption_failed"
+	// ReasonUnsupportedVersion indica versão do formato do token não suportada ou não aceita.
+	ReasonUnsupportedVersion = "unsupported_version"
+	// ReasonAlgorithmMismatch indica tentativa de confusão de algoritmo.
+	ReasonAlgorithmMismatch = "algorithm_mismatch"
+	// ReasonHybridPolicyViolation indica que o token não satisfaz a política híbrida configurada.
+	ReasonHybridPolicyViolation = "hybrid_policy_violation"
+	// ReasonHMACNotAllowed indica um token HMAC rejeitado por falta de habilitação explícita.
+	ReasonHMACNotAllowed = "hmac_not_allowed"
+	// ReasonInsufficientSignatures indica que o limiar de assinaturas (k-de-n) não foi atingido.
+	ReasonInsufficientSignatures = "insufficient_signatures"
 )
 
 // PayloadBuilder implementa a API fluente para construção de payloads Signet.
@@ -72,22 +149,29 @@ const (
 //	    WithRole("admin").
 //	    Build()
 type PayloadBuilder struct {
-	payload *signetv1.SignetPayload
+	payload        *signetv1.SignetPayload
+	signingContext string
+	recipientKid   string
+	maxLifetime    time.Duration
+	err            error
 }
 
-// NewPayload cria um builder com iat = agora e exp = agora + 15min.
+// NewPayload cria um builder com iat = agora, exp = agora + 15min e um jti aleatório de 16 bytes.
 // Este é o ponto de entrada recomendado para criar um novo payload seguro.
-// O desenvolvedor pode sobrescrever esses valores usando WithIssuedAt/WithExpiration.
+// O desenvolvedor pode sobrescrever esses valores usando WithIssuedAt/WithExpiration/WithTokenID.
 //
 // Exemplo:
 //
 //	builder := signet.NewPayload()
 func NewPayload() *PayloadBuilder {
 	now := time.Now().Unix()
+	jti := make([]byte, TokenIDSize)
+	_, _ = rand.Read(jti)
 	return &PayloadBuilder{
 		payload: &signetv1.SignetPayload{
 			Iat: now,
 			Exp: now + 15*60, // 15 minutos
+			Jti: jti,
 		},
 	}
 }
@@ -112,6 +196,33 @@ func (b *PayloadBuilder) WithAudience(aud string) *PayloadBuilder {
 	return b
 }
 
+// WithAudiences define as audiências do payload, substituindo as anteriores, para tokens
+// aceitos por um conjunto de serviços cooperantes. A primeira audiência é gravada em aud,
+// mantendo a compatibilidade com validadores que conhecem apenas esse campo.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithAudiences("api-pedidos", "api-pagamentos")
+func (b *PayloadBuilder) WithAudiences(auds ...string) *PayloadBuilder {
+	b.payload.Aud = ""
+	b.payload.AdditionalAudiences = nil
+	if len(auds) > 0 {
+		b.payload.Aud = auds[0]
+		b.payload.AdditionalAudiences = append([]string(nil), auds[1:]...)
+	}
+	return b
+}
+
+// WithIssuer define o campo iss (issuer) do payload, identificando o emissor do token.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithIssuer("auth.exemplo.com").WithKeyID("v1")
+func (b *PayloadBuilder) WithIssuer(iss string) *PayloadBuilder {
+	b.payload.Iss = iss
+	return b
+}
+
 // WithRole adiciona um papel (role) ao payload.
 //
 // Exemplo:
@@ -131,6 +242,7 @@ func (b *PayloadBuilder) WithCustomClaim(key, value string) *PayloadBuilder {
 	if b.payload.CustomClaims == nil {
 		b.payload.CustomClaims = make(map[string]string)
 	}
+	delete(b.payload.TypedClaims, key)
 	b.payload.CustomClaims[key] = value
 	return b
 }
@@ -145,6 +257,16 @@ func (b *PayloadBuilder) WithSessionID(sid []byte) *PayloadBuilder {
 	return b
 }
 
+// WithTokenID sobrescreve o identificador único do token (jti), gerado automaticamente por NewPayload.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithTokenID(ulid.Make().Bytes())
+func (b *PayloadBuilder) WithTokenID(jti []byte) *PayloadBuilder {
+	b.payload.Jti = jti
+	return b
+}
+
 // WithIssuedAt sobrescreve o campo iat (issued at) do payload.
 //
 // Exemplo:
@@ -155,6 +277,19 @@ func (b *PayloadBuilder) WithIssuedAt(iat int64) *PayloadBuilder {
 	return b
 }
 
+// WithNotBefore define o campo nbf (not before): o token só é aceito a partir deste instante.
+// Permite emitir tokens antecipadamente para uso agendado.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().
+//	    WithNotBefore(inicio.Unix()).
+//	    WithExpiration(inicio.Add(15*time.Minute).Unix())
+func (b *PayloadBuilder) WithNotBefore(nbf int64) *PayloadBuilder {
+	b.payload.Nbf = nbf
+	return b
+}
+
 // WithExpiration sobrescreve o campo exp (expiration) do payload.
 //
 // Exemplo:
@@ -175,14 +310,32 @@ func (b *PayloadBuilder) WithKeyID(kid string) *PayloadBuilder {
 	return b
 }
 
+// WithSigningContext vincula a assinatura a um contexto (ex: "signet.v1:producao"), aplicando
+// separação de domínio. O token só será aceito por validadores configurados com o mesmo
+// contexto via a opção WithSigningContext do Parse, mesmo que as chaves sejam compartilhadas
+// por engano entre ambientes ou reutilizadas por outro sistema.
+//
+// Exemplo:
+//
+//	builder := signet.NewPayload().WithSigningContext("signet.v1:producao")
+func (b *PayloadBuilder) WithSigningContext(signingContext string) *PayloadBuilder {
+	b.signingContext = signingContext
+	return b
+}
+
 // Build valida as regras de negócio e retorna o payload pronto para uso.
-// Valida se exp > iat e se ambos são positivos.
+// Valida se exp > iat e se ambos são positivos, se nbf estiver presente, se iat <= nbf < exp
+// e, se WithMaxLifetime for usado, se exp - iat não excede o limite.
 // Retorna erro se as regras forem violadas.
 //
 // Exemplo:
 //
 //	payload, err := builder.Build()
 func (b *PayloadBuilder) Build() (*signetv1.SignetPayload, error) {
+	// Erros adiados dos métodos fluentes (ex: WithExtension)
+	if b.err != nil {
+		return nil, b.err
+	}
 	// Timestamps não podem ser zero ou negativos
 	if b.payload.Iat <= 0 || b.payload.Exp <= 0 {
 		return nil, ErrInvalidPayload
@@ -191,6 +344,13 @@ func (b *PayloadBuilder) Build() (*signetv1.SignetPayload, error) {
 	if b.payload.Exp <= b.payload.Iat {
 		return nil, ErrInvalidExpIat
 	}
+	if b.payload.Nbf != 0 && (b.payload.Nbf < b.payload.Iat || b.payload.Nbf >= b.payload.Exp) {
+		return nil, ErrInvalidNotBefore
+	}
+	// Limite de validade do emissor (ver WithMaxLifetime)
+	if b.maxLifetime > 0 && lifetime(b.payload) > b.maxLifetime {
+		return nil, fmt.Errorf("validade de %v excede %v: %w", lifetime(b.payload), b.maxLifetime, ErrLifetimeTooLong)
+	}
 	return b.payload, nil
 }
 
@@ -205,18 +365,46 @@ func (b *PayloadBuilder) Build() (*signetv1.SignetPayload, error) {
 //
 //	tokenBytes, err := builder.Sign(privateKey)
 func (b *PayloadBuilder) Sign(privateKey ed25519.PrivateKey) ([]byte, error) {
+	return b.SignWithAlgorithm(AlgEd25519, privateKey)
+}
+
+// SignWithAlgorithm assina o payload com o algoritmo registrado identificado por alg.
+// O tipo de privateKey deve ser o esperado pelo algoritmo (ex: ed25519.PrivateKey para AlgEd25519).
+// O identificador do algoritmo é gravado no campo alg do token.
+//
+// Exemplo:
+//
+//	tokenBytes, err := builder.SignWithAlgorithm(signet.AlgEd25519, privateKey)
+func (b *PayloadBuilder) SignWithAlgorithm(alg string, privateKey crypto.PrivateKey) ([]byte, error) {
+	algorithm, err := core.DefaultRegistry().Lookup(alg)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao selecionar algoritmo '%s': %w", alg, ErrUnsupportedAlgorithm)
+	}
+	return b.signToken(alg, func(message []byte) ([]byte, error) {
+		return algorithm.Sign(privateKey, message)
+	})
+}
+
+// signToken executa o fluxo comum de assinatura, delegando a geração da assinatura principal
+// a signFn e a de eventuais assinaturas adicionais a extraFns. Todas recebem a mensagem
+// efetivamente assinada (os bytes do payload vinculados à versão do formato e ao contexto de assinatura).
+func (b *PayloadBuilder) signToken(alg string, signFn func(message []byte) ([]byte, error), extraFns ...func(message []byte) (*signetv1.SignetSignature, error)) ([]byte, error) {
 	// 1. Construir e validar o payload
 	payload, err := b.Build()
 	if err != nil {
 		return nil, fmt.Errorf("falha ao construir payload: %w", err)
 	}
 	// 2. Serializar o payload para bytes canônicos (protobuf)
-	payloadBytes, err := proto.Marshal(payload)
+	payloadBytes, err := canonicalMarshal.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao serializar payload para protobuf: %w", err)
 	}
-	// 3. Assinar os bytes do payload usando Ed25519
-	signature, err := core.Sign(privateKey, payloadBytes)
+	// 3. Aplicar a separação de domínio e a versão do formato e assinar com o algoritmo selecionado
+	message, err := core.SigningMessage(CurrentTokenVersion, b.signingContext, payloadBytes)
+	if err != nil {
+		return nil, fmt.Errorf("contexto de assinatura: %w", ErrInvalidSigningContext)
+	}
+	signature, err := signFn(message)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao assinar payload no núcleo criptográfico: %w", err)
 	}
@@ -224,9 +412,18 @@ func (b *PayloadBuilder) Sign(privateKey ed25519.PrivateKey) ([]byte, error) {
 	token := &signetv1.SignetToken{
 		Payload:   payloadBytes,
 		Signature: signature,
+		Alg:       alg,
+		Version:   CurrentTokenVersion,
+	}
+	for _, extraFn := range extraFns {
+		extra, err := extraFn(message)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao assinar payload no núcleo criptográfico: %w", err)
+		}
+		token.Signatures = append(token.Signatures, extra)
 	}
 	// 5. Serializar o token final
-	tokenBytes, err := proto.Marshal(token)
+	tokenBytes, err := canonicalMarshal.Marshal(token)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao serializar SignetToken para protobuf: %w", err)
 	}
@@ -259,12 +456,30 @@ type MetricsRecorder interface {
 type ValidationOption func(*validationConfig)
 
 type validationConfig struct {
+	acceptedVersions    map[uint32]struct{}
+	strictEncoding      bool
 	skipExpirationCheck bool
+	clock               Clock
+	leeway              time.Duration
+	maxAge              time.Duration
+	maxLifetime         time.Duration
 	skipIssuedAtCheck   bool
 	expectedAudience    string
+	audienceSet         bool
+	allowedIssuers      map[string]struct{}
 	requiredRoles       []string
 	revocationChecker   func([]byte) bool
+	replayStore         ReplayStore
+	extension           proto.Message
 	metricsRecorder     MetricsRecorder
+	hybridPolicy        HybridPolicy
+	secretResolver      SecretResolver
+	hmacSet             bool
+	signingContext      string
+	thresholdSet        bool
+	requiredSignatures  int
+	trustedKids         map[string]struct{}
+	batchWorkers        int
 }
 
 // WithSkipExpirationCheck permite pular a verificação de expiração (útil para testes).
@@ -285,10 +500,12 @@ func WithSkipIssuedAtCheck() ValidationOption {
 func WithExpectedAudience(audience string) ValidationOption {
 	return func(c *validationConfig) {
 		c.expectedAudience = audience
+		c.audienceSet = true
 	}
 }
 
-// WithAudience exige que o claim aud do payload seja igual ao fornecido.
+// WithAudience exige que a audiência fornecida esteja entre as audiências do token
+// (aud ou additional_audiences).
 //
 // Exemplo:
 //
@@ -296,6 +513,7 @@ func WithExpectedAudience(audience string) ValidationOption {
 func WithAudience(audience string) ValidationOption {
 	return func(c *validationConfig) {
 		c.expectedAudience = audience
+		c.audienceSet = true
 	}
 }
 
@@ -347,6 +565,19 @@ func WithMetricsRecorder(recorder MetricsRecorder) ValidationOption {
 	}
 }
 
+// WithSigningContext exige que o token tenha sido assinado com o contexto fornecido
+// (ver PayloadBuilder.WithSigningContext). Tokens assinados sem contexto ou com outro
+// contexto falham com ErrInvalidSignature.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithSigningContext("signet.v1:producao"))
+func WithSigningContext(signingContext string) ValidationOption {
+	return func(c *validationConfig) {
+		c.signingContext = signingContext
+	}
+}
+
 // KeyResolverFunc define a assinatura para funções que resolvem a chave pública correta
 // para validação do token, com base no 'kid' (Key ID) fornecido.
 //
@@ -382,12 +613,16 @@ type KeyResolverFunc func(ctx context.Context, kid string) (ed25519.PublicKey, e
 //
 // A função executa uma sequência de validações em ordem estrita para garantir
 // a máxima segurança:
-// 1. Deserialização da estrutura externa do Token.
-// 2. Deserialização do payload para extrair o 'kid'.
+// 1. Deserialização da estrutura externa do Token e despacho pela versão do formato
+// (v1 se ausente), rejeitando versões não suportadas ou não aceitas (WithAcceptedVersions).
+// 2. Deserialização do payload para extrair o 'kid' e o 'iss', rejeitando codificações
+// protobuf não canônicas (com WithStrictEncoding) e emissores não aceitos
+// (WithIssuer) antes de qualquer resolução de chave.
 // 3. Resolução da chave pública via KeyResolverFunc.
-// 4. Verificação da assinatura criptográfica Ed25519 ANTES de analisar o conteúdo.
-// 5. Validação dos claims temporais (exp, iat).
-// 6. Execução de validações de claims adicionais (audiência, papéis, revogação, etc.).
+// 4. Verificação da assinatura criptográfica ANTES de analisar o conteúdo, com o
+// algoritmo declarado no campo alg (Ed25519 se ausente).
+// 5. Validação dos claims temporais (exp, iat, nbf).
+// 6. Execução de validações de claims adicionais (audiência, papéis, revogação, replay, etc.).
 // 7. Emissão de métricas de sucesso/falha, se configurado.
 //
 // Retorna o payload validado em caso de sucesso, ou um erro sentinela contextualizado em caso de falha.
@@ -413,79 +648,144 @@ type KeyResolverFunc func(ctx context.Context, kid string) (ed25519.PublicKey, e
 //	    log.Fatalf("Falha ao validar o token: %v", err)
 //	}
 func Parse(ctx context.Context, tokenBytes []byte, keyResolver KeyResolverFunc, options ...ValidationOption) (*signetv1.SignetPayload, error) {
+	return ParseWithResolver(ctx, tokenBytes, keyResolver, options...)
+}
+
+// ParseWithResolver é equivalente a Parse, mas resolve a chave através de um KeyResolver,
+// permitindo chaves de qualquer algoritmo registrado. A chave resolvida só é aceita se
+// tiver sido registrada para o mesmo algoritmo declarado pelo token; caso contrário,
+// retorna ErrAlgorithmMismatch.
+//
+// Exemplo:
+//
+//	payload, err := signet.ParseWithResolver(ctx, tokenBytes, resolver, signet.WithAudience("api-backend"))
+func ParseWithResolver(ctx context.Context, tokenBytes []byte, keyResolver KeyResolver, options ...ValidationOption) (*signetv1.SignetPayload, error) {
+	return parseToken(ctx, tokenBytes, keyResolver, newValidationConfig(options))
+}
+
+// newValidationConfig aplica as opções de validação sobre a configuração padrão.
+func newValidationConfig(options []ValidationOption) *validationConfig {
 	config := &validationConfig{}
 	for _, option := range options {
 		option(config)
 	}
-	recordMetricAndReturn := func(ctx context.Context, success bool, reason string, payload *signetv1.SignetPayload, err error) (*signetv1.SignetPayload, error) {
-		if config.metricsRecorder != nil {
-			config.metricsRecorder.IncrementTokenValidation(ctx, success, reason)
+	return config
+}
+
+// parseToken executa a validação de um token com a configuração já construída.
+func parseToken(ctx context.Context, tokenBytes []byte, keyResolver KeyResolver, config *validationConfig) (*signetv1.SignetPayload, error) {
+	// 1-5. Deserializar, despachar pela versão e verificar a(s) assinatura(s)
+	payload, reason, err := decodeAndVerify(ctx, tokenBytes, keyResolver, config)
+	if err != nil {
+		return nil, config.fail(ctx, reason, payload, err)
+	}
+	// 6. Validações de claims, interrompidas na primeira falha (ver Explain para o relatório completo)
+	for _, check := range claimChecks(payload, config, config.now()) {
+		if reason, err := check.run(); err != nil {
+			return nil, config.fail(ctx, reason, payload, err)
 		}
-		return payload, err
 	}
+	// 7. Proteção contra replay por último, para que tokens rejeitados não consumam o jti
+	if config.replayStore != nil {
+		if reason, err := checkReplay(ctx, config.replayStore, payload, config.leeway); err != nil {
+			return nil, config.fail(ctx, reason, payload, err)
+		}
+	}
+	if config.metricsRecorder != nil {
+		config.metricsRecorder.IncrementTokenValidation(ctx, true, ReasonSuccess)
+	}
+	return payload, nil
+}
+
+// decodeAndVerify deserializa o token e o payload e verifica a(s) assinatura(s).
+// Em caso de falha, retorna a razão de métrica e, se já deserializado, o payload (não verificado)
+// para compor os metadados do erro.
+func decodeAndVerify(ctx context.Context, tokenBytes []byte, keyResolver KeyResolver, config *validationConfig) (*signetv1.SignetPayload, string, error) {
 	// 1. Deserializar o token
 	var token signetv1.SignetToken
 	if err := proto.Unmarshal(tokenBytes, &token); err != nil {
-		return recordMetricAndReturn(ctx, false, ReasonInvalidPayload, nil, fmt.Errorf("falha ao deserializar SignetToken: %w", err))
+		return nil, ReasonInvalidPayload, fmt.Errorf("falha ao deserializar SignetToken: %w: %w", ErrInvalidPayload, err)
+	}
+	// 2. Despachar pela versão do formato antes de interpretar os demais campos
+	version := tokenVersion(&token)
+	if reason, err := checkVersion(version, config); err != nil {
+		return nil, reason, err
+	}
+	switch version {
+	case TokenVersion1:
+		// Formato v1: payload assinado + assinaturas adicionais, validado abaixo
+	default:
+		return nil, ReasonUnsupportedVersion, fmt.Errorf("versão %d: %w", version, ErrUnsupportedVersion)
 	}
 	if token.Payload == nil || token.Signature == nil {
-		return recordMetricAndReturn(ctx, false, ReasonInvalidPayload, nil, ErrInvalidPayload)
+		return nil, ReasonInvalidPayload, ErrInvalidPayload
 	}
-	// 2. Deserializar o payload para extrair o kid
+	// 3. Deserializar o payload para extrair o kid, exigindo a codificação canônica
+	// do envelope e do payload no modo estrito (WithStrictEncoding)
 	var payload signetv1.SignetPayload
 	if err := proto.Unmarshal(token.Payload, &payload); err != nil {
-		return recordMetricAndReturn(ctx, false, ReasonInvalidPayload, nil, fmt.Errorf("falha ao deserializar SignetPayload: %w", err))
-	}
-	// 3. Resolver a chave pública via keyResolver
-	pubKey, err := keyResolver(ctx, payload.Kid)
-	if err != nil {
-		return recordMetricAndReturn(ctx, false, ReasonInvalidSignature, nil, fmt.Errorf("falha ao resolver chave pública para kid '%s': %w", payload.Kid, err))
+		return nil, ReasonInvalidPayload, fmt.Errorf("falha ao deserializar SignetPayload: %w: %w", ErrInvalidPayload, err)
 	}
-	// 4. Verificar a assinatura
-	if err := core.Verify(pubKey, token.Payload, token.Signature); err != nil {
-		if errors.Is(err, core.ErrVerificationFailed) {
-			return recordMetricAndReturn(ctx, false, ReasonInvalidSignature, nil, fmt.Errorf("falha na verificação criptográfica do núcleo: %w", ErrInvalidSignature))
+	if config.strictEncoding {
+		if err := checkCanonical("SignetToken", tokenBytes, &token); err != nil {
+			return &payload, ReasonNonCanonicalEncoding, err
 		}
-		return recordMetricAndReturn(ctx, false, ReasonInvalidSignature, nil, fmt.Errorf("falha na verificação criptográfica do núcleo: %w", err))
-	}
-	// 5. Validações temporais (a menos que explicitamente puladas)
-	now := time.Now().Unix()
-	if !config.skipExpirationCheck {
-		if payload.Exp <= now {
-			return recordMetricAndReturn(ctx, false, ReasonTokenExpired, nil, ErrTokenExpired)
+		if err := checkCanonical("SignetPayload", token.Payload, &payload); err != nil {
+			return &payload, ReasonNonCanonicalEncoding, err
 		}
-	}
-	if !config.skipIssuedAtCheck {
-		if payload.Iat > now {
-			return recordMetricAndReturn(ctx, false, ReasonTokenNotYetValid, nil, ErrTokenNotYetValid)
+		if err := checkAliases(&token, &payload); err != nil {
+			return &payload, ReasonNonCanonicalEncoding, err
 		}
 	}
-	if config.expectedAudience != "" && payload.Aud != config.expectedAudience {
-		return recordMetricAndReturn(ctx, false, ReasonAudienceMismatch, nil, ErrAudienceMismatch)
-	}
-	if len(config.requiredRoles) > 0 {
-		rolesMap := make(map[string]struct{}, len(payload.Roles))
-		for _, r := range payload.Roles {
-			rolesMap[r] = struct{}{}
-		}
-		for _, required := range config.requiredRoles {
-			if _, ok := rolesMap[required]; !ok {
-				return recordMetricAndReturn(ctx, false, ReasonMissingRequiredRole, nil, ErrMissingRequiredRole)
-			}
+	// 4. Restringir os emissores aceitos antes de resolver qualquer chave
+	if config.allowedIssuers != nil {
+		if _, ok := config.allowedIssuers[payload.Iss]; !ok {
+			return &payload, ReasonIssuerMismatch, ErrIssuerMismatch
 		}
 	}
-	if config.revocationChecker != nil && len(payload.Sid) > 0 {
-		if config.revocationChecker(payload.Sid) {
-			return recordMetricAndReturn(ctx, false, ReasonTokenRevoked, nil, ErrTokenRevoked)
-		}
+	// 5. Resolver a(s) chave(s) e verificar a(s) assinatura(s) com o algoritmo declarado,
+	// exigindo que cada chave pertença a ele, conforme a política híbrida configurada
+	if reason, err := verifyToken(ctx, &token, &payload, keyResolver, config); err != nil {
+		return &payload, reason, err
 	}
-	return recordMetricAndReturn(ctx, true, ReasonSuccess, &payload, nil)
+	return &payload, "", nil
 }
 
 // contextKey é uma chave privada para evitar colisão no contexto
 // Não exportada para garantir isolamento
 var contextKey = struct{}{}
 
+// Audiences retorna todas as audiências do payload: aud seguida de additional_audiences.
+//
+// Exemplo:
+//
+//	for _, aud := range signet.Audiences(payload) {
+//	    log.Println(aud)
+//	}
+func Audiences(payload *signetv1.SignetPayload) []string {
+	if payload == nil {
+		return nil
+	}
+	auds := make([]string, 0, 1+len(payload.AdditionalAudiences))
+	if payload.Aud != "" {
+		auds = append(auds, payload.Aud)
+	}
+	return append(auds, payload.AdditionalAudiences...)
+}
+
+// hasAudience indica se a audiência está entre as audiências do payload.
+func hasAudience(payload *signetv1.SignetPayload, audience string) bool {
+	if payload.Aud == audience {
+		return true
+	}
+	for _, aud := range payload.AdditionalAudiences {
+		if aud == audience {
+			return true
+		}
+	}
+	return false
+}
+
 // InjectPayloadIntoContext injeta o payload validado no contexto para uso downstream (ex: gRPC).
 //
 // Exemplo:
@@ -6,6 +6,10 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
 )
 
 // Testa se o NewPayload define iat e exp corretamente (segurança por padrão)
@@ -152,7 +156,13 @@ func TestParse_AssinaturaInvalida(t *testing.T) {
 	if err != nil {
 		t.Fatalf("erro ao assinar: %v", err)
 	}
-	tokenBytes[len(tokenBytes)-1] ^= 0xFF
+	// Corrompe o último byte da assinatura dentro do envelope
+	var token signetv1.SignetToken
+	if err := proto.Unmarshal(tokenBytes, &token); err != nil {
+		t.Fatalf("erro ao deserializar token: %v", err)
+	}
+	token.Signature[len(token.Signature)-1] ^= 0xFF
+	tokenBytes, _ = proto.Marshal(&token)
 	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
 		return pub, nil
 	}
@@ -244,6 +254,108 @@ func TestParse_RequireRole(t *testing.T) {
 	}
 }
 
+// Testa tokens com várias audiências e a compatibilidade com o campo aud
+func TestParse_WithAudiences(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	builder := NewPayload().WithAudiences("servico-a", "servico-b", "servico-c")
+	payload, _ := builder.Build()
+	if payload.Aud != "servico-a" {
+		t.Errorf("a primeira audiência deveria ser gravada em aud, obteve %q", payload.Aud)
+	}
+	if got := Audiences(payload); len(got) != 3 || got[2] != "servico-c" {
+		t.Errorf("audiências inesperadas: %v", got)
+	}
+	tokenBytes, err := builder.Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		audience      string
+		expectedError error
+	}{
+		{"Sucesso: primeira audiência", "servico-a", nil},
+		{"Sucesso: audiência adicional", "servico-c", nil},
+		{"Falha: audiência ausente", "servico-x", ErrAudienceMismatch},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tokenBytes, keyResolver, WithAudience(tc.audience))
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// WithAudiences substitui as audiências anteriores
+	payload, _ = NewPayload().WithAudiences("x", "y").WithAudiences("z").Build()
+	if got := Audiences(payload); len(got) != 1 || got[0] != "z" {
+		t.Errorf("esperava apenas 'z', obteve %v", got)
+	}
+	if got := Audiences(&signetv1.SignetPayload{}); len(got) != 0 {
+		t.Errorf("payload sem audiência deveria retornar lista vazia, obteve %v", got)
+	}
+}
+
+// Testa a regra temporal iat <= nbf < exp no Build
+func TestPayloadBuilder_NotBefore(t *testing.T) {
+	testCases := []struct {
+		name          string
+		iat, nbf, exp int64
+		expectedError error
+	}{
+		{"Sucesso: nbf ausente", 100, 0, 200, nil},
+		{"Sucesso: nbf igual a iat", 100, 100, 200, nil},
+		{"Sucesso: nbf entre iat e exp", 100, 150, 200, nil},
+		{"Falha: nbf anterior a iat", 100, 50, 200, ErrInvalidNotBefore},
+		{"Falha: nbf igual a exp", 100, 200, 200, ErrInvalidNotBefore},
+		{"Falha: nbf após exp", 100, 300, 200, ErrInvalidNotBefore},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPayload().WithIssuedAt(tc.iat).WithNotBefore(tc.nbf).WithExpiration(tc.exp).Build()
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+// Testa tokens emitidos antecipadamente, válidos apenas a partir de nbf
+func TestParse_NotBefore(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	agora := time.Now().Unix()
+	agendado, _ := NewPayload().WithNotBefore(agora + 3600).WithExpiration(agora + 7200).Sign(priv)
+	vigente, err := NewPayload().WithIssuedAt(agora - 60).WithNotBefore(agora - 10).Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		expectedError error
+	}{
+		{"Sucesso: nbf no passado", vigente, nil},
+		{"Falha: nbf no futuro", agendado, ErrTokenNotBefore},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
 // Testa validação declarativa de revogação (WithRevocationCheck) usando table-driven
 func TestParse_WithRevocationCheck(t *testing.T) {
 	pub, priv, _ := ed25519.GenerateKey(nil)
@@ -276,3 +388,47 @@ func TestParse_WithRevocationCheck(t *testing.T) {
 		})
 	}
 }
+
+// Testa a separação de domínio por contexto de assinatura (WithSigningContext)
+func TestParse_WithSigningContext(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	staging, err := NewPayload().WithSigningContext("signet.v1:staging").Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	semContexto, _ := NewPayload().Sign(priv)
+	secret := make([]byte, MinHMACSecretSize)
+	hmacStaging, _ := NewPayload().WithSigningContext("signet.v1:staging").SignHMAC(secret)
+	secretResolver := func(ctx context.Context, kid string) ([]byte, error) { return secret, nil }
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: mesmo contexto", staging, []ValidationOption{WithSigningContext("signet.v1:staging")}, nil},
+		{"Falha: token de staging em produção", staging, []ValidationOption{WithSigningContext("signet.v1:producao")}, ErrInvalidSignature},
+		{"Falha: token com contexto em validador sem contexto", staging, nil, ErrInvalidSignature},
+		{"Falha: token sem contexto em validador com contexto", semContexto, []ValidationOption{WithSigningContext("signet.v1:staging")}, ErrInvalidSignature},
+		{"Sucesso: HMAC com mesmo contexto", hmacStaging, []ValidationOption{AllowHMAC(secretResolver), WithSigningContext("signet.v1:staging")}, nil},
+		{"Falha: HMAC em outro contexto", hmacStaging, []ValidationOption{AllowHMAC(secretResolver), WithSigningContext("signet.v1:producao")}, ErrInvalidSignature},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	longo := string(make([]byte, 256))
+	if _, err := NewPayload().WithSigningContext(longo).Sign(priv); !errors.Is(err, ErrInvalidSigningContext) {
+		t.Errorf("esperava ErrInvalidSigningContext, obteve: %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package signet
+
+import (
+	"context"
+	"fmt"
+	"hash/maphash"
+	"sync"
+	"time"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// TokenIDSize é o tamanho, em bytes, do jti gerado automaticamente por NewPayload.
+const TokenIDSize = 16
+
+// ReplayStore registra os jtis de tokens já utilizados.
+// Implementações DEVEM ser seguras para uso concorrente e atômicas: entre chamadas
+// simultâneas com o mesmo jti, apenas uma pode retornar true. Implementações distribuídas
+// (ex: Redis com SET NX e expiração) permitem proteção contra replay entre réplicas.
+type ReplayStore interface {
+	// MarkUsed registra o jti até expiresAt e retorna true se ele ainda não havia sido
+	// utilizado, ou false se já foi registrado e ainda não expirou.
+	MarkUsed(ctx context.Context, jti []byte, expiresAt time.Time) (bool, error)
+}
+
+// WithReplayProtection ativa a proteção contra replay: cada jti é aceito uma única vez até
+// a expiração do token. Tokens repetidos falham com ErrTokenReplayed, e tokens sem jti são
+// rejeitados com ErrInvalidPayload. O jti só é registrado após todas as demais validações.
+// Falhas do store rejeitam o token (fail closed).
+//
+// Exemplo:
+//
+//	store := signet.NewMemoryReplayStore()
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithReplayProtection(store))
+func WithReplayProtection(store ReplayStore) ValidationOption {
+	return func(c *validationConfig) {
+		c.replayStore = store
+	}
+}
+
+// checkReplay registra o jti do payload no store, rejeitando tokens já utilizados.
+// O jti é retido até exp + leeway, enquanto o token ainda pode ser aceito.
+func checkReplay(ctx context.Context, store ReplayStore, payload *signetv1.SignetPayload, leeway time.Duration) (string, error) {
+	if len(payload.Jti) == 0 {
+		return ReasonInvalidPayload, fmt.Errorf("jti ausente com proteção contra replay ativa: %w", ErrInvalidPayload)
+	}
+	firstUse, err := store.MarkUsed(ctx, payload.Jti, time.Unix(payload.Exp, 0).Add(leeway))
+	if err != nil {
+		return ReasonReplayStoreError, fmt.Errorf("falha ao consultar o armazenamento de replay: %w", err)
+	}
+	if !firstUse {
+		return ReasonTokenReplayed, ErrTokenReplayed
+	}
+	return "", nil
+}
+
+// memoryReplayShards é o número de shards do MemoryReplayStore.
+const memoryReplayShards = 64
+
+// memoryReplaySweepInterval é o intervalo mínimo entre remoções de jtis expirados em um shard.
+const memoryReplaySweepInterval = time.Minute
+
+// MemoryReplayStore é um ReplayStore em memória, particionado em shards com locks
+// independentes para reduzir a contenção sob alta concorrência. Os jtis expirados são
+// removidos periodicamente durante as inserções.
+// Adequado para uma única instância; múltiplas réplicas exigem um store compartilhado.
+type MemoryReplayStore struct {
+	seed   maphash.Seed
+	clock  Clock
+	shards [memoryReplayShards]memoryReplayShard
+}
+
+type memoryReplayShard struct {
+	mu        sync.Mutex
+	entries   map[string]time.Time
+	nextSweep time.Time
+}
+
+// NewMemoryReplayStore cria um ReplayStore em memória.
+func NewMemoryReplayStore() *MemoryReplayStore {
+	return NewMemoryReplayStoreWithClock(systemClock{})
+}
+
+// NewMemoryReplayStoreWithClock cria um ReplayStore em memória cujas expirações seguem o
+// relógio fornecido. Deve ser o mesmo relógio passado a WithClock.
+//
+// Exemplo:
+//
+//	clock := signettest.NewFakeClock(time.Now())
+//	store := signet.NewMemoryReplayStoreWithClock(clock)
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver,
+//	    signet.WithClock(clock), signet.WithReplayProtection(store))
+func NewMemoryReplayStoreWithClock(clock Clock) *MemoryReplayStore {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	s := &MemoryReplayStore{seed: maphash.MakeSeed(), clock: clock}
+	for i := range s.shards {
+		s.shards[i].entries = make(map[string]time.Time)
+	}
+	return s
+}
+
+// MarkUsed implementa ReplayStore.
+func (s *MemoryReplayStore) MarkUsed(ctx context.Context, jti []byte, expiresAt time.Time) (bool, error) {
+	now := s.clock.Now()
+	shard := &s.shards[maphash.Bytes(s.seed, jti)%memoryReplayShards]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if !now.Before(shard.nextSweep) {
+		for key, exp := range shard.entries {
+			if !now.Before(exp) {
+				delete(shard.entries, key)
+			}
+		}
+		shard.nextSweep = now.Add(memoryReplaySweepInterval)
+	}
+	if exp, seen := shard.entries[string(jti)]; seen && now.Before(exp) {
+		return false, nil
+	}
+	shard.entries[string(jti)] = expiresAt
+	return true, nil
+}
+
+// Len retorna o número de jtis registrados, incluindo os expirados ainda não removidos.
+func (s *MemoryReplayStore) Len() int {
+	n := 0
+	for i := range s.shards {
+		s.shards[i].mu.Lock()
+		n += len(s.shards[i].entries)
+		s.shards[i].mu.Unlock()
+	}
+	return n
+}
@@ -0,0 +1,108 @@
+package signet
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failingReplayStore simula um armazenamento de replay indisponível.
+type failingReplayStore struct{}
+
+func (failingReplayStore) MarkUsed(ctx context.Context, jti []byte, expiresAt time.Time) (bool, error) {
+	return false, errors.New("store indisponível")
+}
+
+// Testa que NewPayload gera um jti único por token
+func TestNewPayload_GeneratesTokenID(t *testing.T) {
+	a, _ := NewPayload().Build()
+	b, _ := NewPayload().Build()
+	if len(a.Jti) != TokenIDSize {
+		t.Fatalf("esperava jti de %d bytes, obteve %d", TokenIDSize, len(a.Jti))
+	}
+	if bytes.Equal(a.Jti, b.Jti) {
+		t.Error("payloads distintos não deveriam compartilhar o jti")
+	}
+	c, _ := NewPayload().WithTokenID([]byte("jti-fixo")).Build()
+	if string(c.Jti) != "jti-fixo" {
+		t.Errorf("WithTokenID não sobrescreveu o jti: %q", c.Jti)
+	}
+}
+
+// Testa a rejeição de tokens repetidos com WithReplayProtection
+func TestParse_WithReplayProtection(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	store := NewMemoryReplayStore()
+	tokenBytes, _ := NewPayload().WithAudience("api").Sign(priv)
+	otherToken, _ := NewPayload().WithAudience("api").Sign(priv)
+	noJti, _ := NewPayload().WithTokenID(nil).Sign(priv)
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Falha: token rejeitado por outra validação não consome o jti", tokenBytes, []ValidationOption{WithReplayProtection(store), WithAudience("outra")}, ErrAudienceMismatch},
+		{"Sucesso: primeiro uso", tokenBytes, []ValidationOption{WithReplayProtection(store)}, nil},
+		{"Falha: replay", tokenBytes, []ValidationOption{WithReplayProtection(store)}, ErrTokenReplayed},
+		{"Sucesso: outro token", otherToken, []ValidationOption{WithReplayProtection(store)}, nil},
+		{"Sucesso: sem proteção contra replay", tokenBytes, nil, nil},
+		{"Falha: token sem jti", noJti, []ValidationOption{WithReplayProtection(store)}, ErrInvalidPayload},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Falhas do store rejeitam o token
+	if _, err := Parse(context.Background(), otherToken, keyResolver, WithReplayProtection(failingReplayStore{})); err == nil {
+		t.Error("falha do store deveria rejeitar o token")
+	}
+}
+
+// Testa a atomicidade do MemoryReplayStore sob concorrência e a expiração dos jtis
+func TestMemoryReplayStore(t *testing.T) {
+	store := NewMemoryReplayStore()
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Minute)
+
+	var firstUses atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ok, _ := store.MarkUsed(ctx, []byte("jti-concorrente"), expiresAt); ok {
+				firstUses.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if firstUses.Load() != 1 {
+		t.Errorf("esperava exatamente um primeiro uso, obteve %d", firstUses.Load())
+	}
+
+	// Um jti expirado pode ser registrado novamente
+	if ok, _ := store.MarkUsed(ctx, []byte("jti-expirado"), time.Now().Add(-time.Second)); !ok {
+		t.Error("primeiro uso deveria ser aceito")
+	}
+	if ok, _ := store.MarkUsed(ctx, []byte("jti-expirado"), expiresAt); !ok {
+		t.Error("jti expirado deveria ser aceito novamente")
+	}
+	if store.Len() != 2 {
+		t.Errorf("esperava 2 jtis registrados, obteve %d", store.Len())
+	}
+}
@@ -0,0 +1,139 @@
+package signet
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// EncX25519A256GCM identifica o esquema de cifragem dos tokens selados: acordo de chaves
+// X25519 com chave efêmera, derivação HKDF-SHA256 e AES-256-GCM.
+const EncX25519A256GCM = core.EncX25519A256GCM
+
+// RecipientKeyResolverFunc resolve a chave privada X25519 do destinatário com base no
+// recipient_kid do token selado, permitindo a rotação das chaves de cifragem.
+//
+// Exemplo:
+//
+//	recipients := func(ctx context.Context, kid string) (*ecdh.PrivateKey, error) {
+//	    key, ok := decryptionKeys[kid]
+//	    if !ok {
+//	        return nil, signet.ErrUnknownKeyID
+//	    }
+//	    return key, nil
+//	}
+type RecipientKeyResolverFunc func(ctx context.Context, kid string) (*ecdh.PrivateKey, error)
+
+// WithRecipientKeyID define o kid da chave X25519 do destinatário, gravado no token selado
+// por SealFor para que o destinatário selecione a chave privada correta durante a rotação.
+func (b *PayloadBuilder) WithRecipientKeyID(kid string) *PayloadBuilder {
+	b.recipientKid = kid
+	return b
+}
+
+// SealFor assina o payload com Ed25519 e cifra o token assinado para a chave pública X25519
+// do destinatário (sign-then-encrypt). Apenas o portador da chave privada correspondente
+// consegue ler os claims; o token é aberto e validado com OpenAndParse.
+//
+// Exemplo:
+//
+//	sealed, err := signet.NewPayload().
+//	    WithSubject("user-123").
+//	    WithCustomClaim("tenant", "acme").
+//	    WithRecipientKeyID("gateway-enc-v2").
+//	    SealFor(gatewayPublicKey, signingKey)
+func (b *PayloadBuilder) SealFor(recipientPub *ecdh.PublicKey, signingKey ed25519.PrivateKey) ([]byte, error) {
+	tokenBytes, err := b.Sign(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	return Seal(tokenBytes, b.recipientKid, recipientPub)
+}
+
+// Seal cifra um token já assinado, por qualquer algoritmo, para a chave pública X25519 do
+// destinatário identificada por recipientKid.
+//
+// Exemplo:
+//
+//	tokenBytes, _ := signet.NewPayload().SignWith(ctx, kmsSigner)
+//	sealed, err := signet.Seal(tokenBytes, "gateway-enc-v2", gatewayPublicKey)
+func Seal(tokenBytes []byte, recipientKid string, recipientPub *ecdh.PublicKey) ([]byte, error) {
+	aad := core.SealAAD(EncX25519A256GCM, recipientKid)
+	ephemeral, ciphertext, err := core.Seal(recipientPub, aad, tokenBytes)
+	if err != nil {
+		if errors.Is(err, core.ErrInvalidPublicKey) {
+			return nil, fmt.Errorf("chave do destinatário deve ser X25519: %w", ErrInvalidPublicKey)
+		}
+		return nil, fmt.Errorf("falha ao selar token: %w", err)
+	}
+	sealedBytes, err := canonicalMarshal.Marshal(&signetv1.SignetSealedToken{
+		Enc:                EncX25519A256GCM,
+		RecipientKid:       recipientKid,
+		EphemeralPublicKey: ephemeral,
+		Ciphertext:         ciphertext,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao serializar SignetSealedToken para protobuf: %w", err)
+	}
+	return sealedBytes, nil
+}
+
+// OpenAndParse decifra um token selado com a chave privada X25519 do destinatário e valida
+// o token assinado contido nele como ParseWithResolver. Falhas de abertura retornam
+// ErrDecryptionFailed, sem distinguir chave incorreta de adulteração.
+//
+// Exemplo:
+//
+//	payload, err := signet.OpenAndParse(ctx, sealed, gatewayPrivateKey, resolver, signet.WithAudience("gateway"))
+func OpenAndParse(ctx context.Context, sealedBytes []byte, recipientPriv *ecdh.PrivateKey, keyResolver KeyResolver, options ...ValidationOption) (*signetv1.SignetPayload, error) {
+	recipients := func(ctx context.Context, kid string) (*ecdh.PrivateKey, error) {
+		return recipientPriv, nil
+	}
+	return OpenAndParseWithRecipientResolver(ctx, sealedBytes, recipients, keyResolver, options...)
+}
+
+// OpenAndParseWithRecipientResolver é equivalente a OpenAndParse, mas seleciona a chave
+// privada do destinatário pelo recipient_kid do token, permitindo a rotação das chaves de cifragem.
+//
+// Exemplo:
+//
+//	payload, err := signet.OpenAndParseWithRecipientResolver(ctx, sealed, recipients, resolver)
+func OpenAndParseWithRecipientResolver(ctx context.Context, sealedBytes []byte, recipients RecipientKeyResolverFunc, keyResolver KeyResolver, options ...ValidationOption) (*signetv1.SignetPayload, error) {
+	config := newValidationConfig(options)
+	tokenBytes, reason, err := openSealed(ctx, sealedBytes, recipients)
+	if err != nil {
+		return nil, config.fail(ctx, reason, nil, err)
+	}
+	return parseToken(ctx, tokenBytes, keyResolver, config)
+}
+
+// openSealed deserializa o token selado, resolve a chave do destinatário e decifra o token assinado.
+func openSealed(ctx context.Context, sealedBytes []byte, recipients RecipientKeyResolverFunc) ([]byte, string, error) {
+	var sealed signetv1.SignetSealedToken
+	if err := proto.Unmarshal(sealedBytes, &sealed); err != nil {
+		return nil, ReasonInvalidPayload, fmt.Errorf("falha ao deserializar SignetSealedToken: %w", ErrInvalidPayload)
+	}
+	if sealed.Enc != EncX25519A256GCM {
+		return nil, ReasonDecryptionFailed, fmt.Errorf("esquema de cifragem '%s' não suportado: %w", sealed.Enc, ErrDecryptionFailed)
+	}
+	recipientPriv, err := recipients(ctx, sealed.RecipientKid)
+	if err != nil {
+		return nil, ReasonDecryptionFailed, fmt.Errorf("falha ao resolver chave do destinatário '%s': %w", sealed.RecipientKid, err)
+	}
+	aad := core.SealAAD(sealed.Enc, sealed.RecipientKid)
+	tokenBytes, err := core.Open(recipientPriv, sealed.EphemeralPublicKey, aad, sealed.Ciphertext)
+	if err != nil {
+		if errors.Is(err, core.ErrInvalidPrivateKey) {
+			return nil, ReasonDecryptionFailed, fmt.Errorf("chave do destinatário deve ser X25519: %w", ErrInvalidPrivateKey)
+		}
+		return nil, ReasonDecryptionFailed, ErrDecryptionFailed
+	}
+	return tokenBytes, "", nil
+}
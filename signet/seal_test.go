@@ -0,0 +1,137 @@
+package signet
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Testa o fluxo sign-then-encrypt e a confidencialidade dos claims
+func TestSealFor_OpenAndParse(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	recipient, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	other, _ := ecdh.X25519().GenerateKey(rand.Reader)
+
+	sealed, err := NewPayload().
+		WithSubject("user-123").
+		WithAudience("gateway").
+		WithCustomClaim("conta_interna", "acct-998877").
+		SealFor(recipient.PublicKey(), priv)
+	if err != nil {
+		t.Fatalf("erro ao selar: %v", err)
+	}
+	if bytes.Contains(sealed, []byte("acct-998877")) || bytes.Contains(sealed, []byte("user-123")) {
+		t.Fatal("claims legíveis no token selado")
+	}
+
+	payload, err := OpenAndParse(context.Background(), sealed, recipient, resolver, WithAudience("gateway"))
+	if err != nil {
+		t.Fatalf("erro ao abrir: %v", err)
+	}
+	if tenant, _ := ClaimString(payload, "conta_interna"); tenant != "acct-998877" || payload.Sub != "user-123" {
+		t.Errorf("claims inesperados após abertura: %v", payload)
+	}
+
+	// Token selado com assinatura inválida é rejeitado após a abertura
+	_, wrongSigner, _ := ed25519.GenerateKey(nil)
+	forged, _ := NewPayload().SealFor(recipient.PublicKey(), wrongSigner)
+	tampered := append([]byte(nil), sealed...)
+	tampered[len(tampered)-1] ^= 0xFF
+
+	recorder := &countingRecorder{}
+	testCases := []struct {
+		name          string
+		token         []byte
+		key           *ecdh.PrivateKey
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Falha: chave do destinatário incorreta", sealed, other, []ValidationOption{WithMetricsRecorder(recorder)}, ErrDecryptionFailed},
+		{"Falha: token selado adulterado", tampered, recipient, []ValidationOption{WithMetricsRecorder(recorder)}, ErrDecryptionFailed},
+		{"Falha: assinatura inválida dentro do selo", forged, recipient, nil, ErrInvalidSignature},
+		{"Falha: validações do Parse continuam ativas", sealed, recipient, []ValidationOption{WithAudience("outra")}, ErrAudienceMismatch},
+		{"Falha: chave privada ausente", sealed, nil, nil, ErrInvalidPrivateKey},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := OpenAndParse(context.Background(), tc.token, tc.key, resolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+	if recorder.reasons[ReasonDecryptionFailed] != 2 {
+		t.Errorf("esperava 2 métricas %q, obteve %v", ReasonDecryptionFailed, recorder.reasons)
+	}
+
+	// Um token selado não é aceito como token assinado comum
+	if _, err := ParseWithResolver(context.Background(), sealed, resolver); err == nil {
+		t.Error("token selado não deveria ser aceito pelo Parse")
+	}
+}
+
+// Testa a seleção da chave do destinatário pelo kid durante a rotação
+func TestOpenAndParseWithRecipientResolver(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	oldKey, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	newKey, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	recipientKeys := map[string]*ecdh.PrivateKey{"enc-v1": oldKey, "enc-v2": newKey}
+	recipients := func(ctx context.Context, kid string) (*ecdh.PrivateKey, error) {
+		key, ok := recipientKeys[kid]
+		if !ok {
+			return nil, ErrUnknownKeyID
+		}
+		return key, nil
+	}
+
+	oldSealed, _ := NewPayload().WithRecipientKeyID("enc-v1").SealFor(oldKey.PublicKey(), priv)
+	newSealed, _ := NewPayload().WithRecipientKeyID("enc-v2").SealFor(newKey.PublicKey(), priv)
+	unknown, _ := NewPayload().WithRecipientKeyID("enc-v3").SealFor(newKey.PublicKey(), priv)
+
+	// O recipient_kid é autenticado: trocá-lo invalida o selo
+	var relabeled signetv1.SignetSealedToken
+	_ = proto.Unmarshal(newSealed, &relabeled)
+	relabeled.RecipientKid = "enc-v1"
+	relabeledBytes, _ := proto.Marshal(&relabeled)
+
+	testCases := []struct {
+		name          string
+		token         []byte
+		expectedError error
+	}{
+		{"Sucesso: chave antiga durante a rotação", oldSealed, nil},
+		{"Sucesso: chave nova", newSealed, nil},
+		{"Falha: kid do destinatário desconhecido", unknown, ErrUnknownKeyID},
+		{"Falha: kid do destinatário trocado", relabeledBytes, ErrDecryptionFailed},
+		{"Falha: bytes corrompidos", []byte{0xFF, 0xFF}, ErrInvalidPayload},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := OpenAndParseWithRecipientResolver(context.Background(), tc.token, recipients, resolver)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+
+	// Seal aceita tokens assinados por qualquer algoritmo, mas apenas chaves X25519
+	p256, _ := ecdh.P256().GenerateKey(rand.Reader)
+	tokenBytes, _ := NewPayload().Sign(priv)
+	if _, err := Seal(tokenBytes, "enc-v1", p256.PublicKey()); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("esperava ErrInvalidPublicKey, obteve: %v", err)
+	}
+}
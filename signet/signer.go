@@ -0,0 +1,114 @@
+package signet
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"io"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+)
+
+// ContextSigner é um crypto.Signer que recebe o contexto da requisição, permitindo
+// propagar cancelamento e deadlines para assinadores remotos (KMS, HSM, etc.).
+// SignWith usa SignContext quando o assinador implementa esta interface.
+type ContextSigner interface {
+	crypto.Signer
+	SignContext(ctx context.Context, rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error)
+}
+
+// SoftwareSigner implementa ContextSigner para chaves mantidas em memória
+// (ed25519.PrivateKey, *ecdsa.PrivateKey), respeitando o cancelamento do contexto.
+type SoftwareSigner struct {
+	key crypto.Signer
+}
+
+// NewSoftwareSigner cria um ContextSigner a partir de uma chave privada em memória.
+//
+// Exemplo:
+//
+//	signer := signet.NewSoftwareSigner(privateKey)
+//	tokenBytes, err := signet.NewPayload().SignWith(ctx, signer)
+func NewSoftwareSigner(key crypto.Signer) *SoftwareSigner {
+	return &SoftwareSigner{key: key}
+}
+
+// Public retorna a chave pública correspondente.
+func (s *SoftwareSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+// Sign implementa crypto.Signer.
+func (s *SoftwareSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
+
+// SignContext implementa ContextSigner.
+func (s *SoftwareSigner) SignContext(ctx context.Context, rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.key.Sign(rand, digest, opts)
+}
+
+// SignWith assina o payload através de um crypto.Signer, sem que a chave privada precise
+// estar na memória do processo. O algoritmo é selecionado pelo tipo de signer.Public()
+// (Ed25519 ou ECDSA P-256/P-384). Se o assinador implementar ContextSigner, o contexto é
+// propagado; caso contrário, apenas o cancelamento prévio é verificado.
+//
+// A assinatura retornada é verificada localmente contra signer.Public() antes de o token
+// ser emitido. Falhas do assinador são retornadas envolvendo ErrSignerFailed.
+//
+// Exemplo:
+//
+//	tokenBytes, err := signet.NewPayload().WithKeyID("kms-v1").SignWith(ctx, kmsSigner)
+//	if errors.Is(err, signet.ErrSignerFailed) {
+//	    // Falha no KMS/HSM...
+//	}
+func (b *PayloadBuilder) SignWith(ctx context.Context, signer crypto.Signer) ([]byte, error) {
+	alg, signFn, err := signerSignFunc(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	return b.signToken(alg, signFn)
+}
+
+// signerSignFunc seleciona o algoritmo pelo tipo de signer.Public() e retorna uma função que
+// assina mensagens através do signer, verificando localmente cada assinatura produzida.
+func signerSignFunc(ctx context.Context, signer crypto.Signer) (string, func(message []byte) ([]byte, error), error) {
+	if signer == nil {
+		return "", nil, ErrInvalidPrivateKey
+	}
+	publicKey := signer.Public()
+	alg, err := core.AlgorithmForPublicKey(publicKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("tipo de chave do assinador não suportado: %w", ErrUnsupportedAlgorithm)
+	}
+	algorithm, err := core.DefaultRegistry().Lookup(alg)
+	if err != nil {
+		return "", nil, fmt.Errorf("falha ao selecionar algoritmo '%s': %w", alg, ErrUnsupportedAlgorithm)
+	}
+	signerAlgorithm, ok := algorithm.(core.SignerAlgorithm)
+	if !ok {
+		return "", nil, fmt.Errorf("algoritmo '%s' não suporta crypto.Signer: %w", alg, ErrUnsupportedAlgorithm)
+	}
+	signFn := func(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if cs, ok := signer.(ContextSigner); ok {
+			return cs.SignContext(ctx, rand, digest, opts)
+		}
+		return signer.Sign(rand, digest, opts)
+	}
+	return alg, func(message []byte) ([]byte, error) {
+		signature, err := signerAlgorithm.SignWithSigner(signFn, message)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSignerFailed, err)
+		}
+		if err := algorithm.Verify(publicKey, message, signature); err != nil {
+			return nil, fmt.Errorf("%w: assinatura não confere com a chave pública do assinador: %w", ErrSignerFailed, err)
+		}
+		return signature, nil
+	}, nil
+}
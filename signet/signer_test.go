@@ -0,0 +1,97 @@
+package signet
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucas-de-lima/signet-go/signet/signettest"
+)
+
+// Testa a assinatura via crypto.Signer (Ed25519 e ECDSA) com validação round-trip
+func TestSignWith_RoundTrip(t *testing.T) {
+	_, edPriv, _ := ed25519.GenerateKey(nil)
+	ecPriv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	resolver := VerificationKeyResolverFunc(func(ctx context.Context, req KeyRequest) (VerificationKey, error) {
+		if req.KeyID == "ec" {
+			return VerificationKey{Algorithm: AlgES256, Key: &ecPriv.PublicKey}, nil
+		}
+		return VerificationKey{Algorithm: AlgEd25519, Key: edPriv.Public()}, nil
+	})
+
+	testCases := []struct {
+		name   string
+		kid    string
+		signer crypto.Signer
+	}{
+		{"Ed25519 via crypto.Signer", "ed", edPriv},
+		{"ECDSA via crypto.Signer", "ec", ecPriv},
+		{"Ed25519 via SoftwareSigner", "ed", NewSoftwareSigner(edPriv)},
+		{"ECDSA via FakeSigner", "ec", signettest.NewFakeSigner(ecPriv)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenBytes, err := NewPayload().WithSubject("user-kms").WithKeyID(tc.kid).SignWith(context.Background(), tc.signer)
+			if err != nil {
+				t.Fatalf("erro ao assinar: %v", err)
+			}
+			payload, err := ParseWithResolver(context.Background(), tokenBytes, resolver)
+			if err != nil {
+				t.Fatalf("erro ao validar token: %v", err)
+			}
+			if payload.Sub != "user-kms" {
+				t.Error("payload retornado não bate com o original")
+			}
+		})
+	}
+}
+
+// Testa que falhas do assinador remoto são envolvidas em ErrSignerFailed
+func TestSignWith_SignerFailures(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+
+	failing := signettest.NewFakeSigner(priv)
+	failing.FailNext(1)
+
+	corrupt := signettest.NewFakeSigner(priv)
+	corrupt.Corrupt = true
+
+	slow := signettest.NewFakeSigner(priv)
+	slow.Latency = time.Second
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		signer        crypto.Signer
+		expectedError error
+	}{
+		{"Falha simulada", context.Background(), failing, signettest.ErrSimulatedFailure},
+		{"Assinatura adulterada", context.Background(), corrupt, ErrSignerFailed},
+		{"Deadline excedido", timeoutCtx, slow, context.DeadlineExceeded},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPayload().SignWith(tc.ctx, tc.signer)
+			if !errors.Is(err, ErrSignerFailed) {
+				t.Errorf("esperava ErrSignerFailed, obteve: %v", err)
+			}
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v' na cadeia, obteve: %v", tc.expectedError, err)
+			}
+		})
+	}
+
+	if _, err := NewPayload().SignWith(context.Background(), nil); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("esperava ErrInvalidPrivateKey para assinador nulo, obteve: %v", err)
+	}
+}
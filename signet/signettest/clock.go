@@ -0,0 +1,46 @@
+package signettest
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock é um relógio controlado manualmente que implementa signet.Clock, permitindo
+// testar expiração, iat e nbf sem WithSkipExpirationCheck. É seguro para uso concorrente.
+//
+// Exemplo:
+//
+//	clock := signettest.NewFakeClock(time.Now())
+//	tokenBytes, _ := signet.NewPayload().WithExpiration(clock.Now().Add(time.Minute).Unix()).Sign(priv)
+//	clock.Advance(2 * time.Minute)
+//	_, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithClock(clock)) // errors.Is(err, signet.ErrTokenExpired)
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock cria um FakeClock parado no instante fornecido.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now retorna o instante atual do relógio.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance avança o relógio em d (ou o retrocede, se d for negativo).
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set posiciona o relógio no instante fornecido.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
@@ -0,0 +1,26 @@
+package signettest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock_AdvanceAndSet(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	clock := NewFakeClock(start)
+	if !clock.Now().Equal(start) {
+		t.Fatalf("esperava %v, obteve %v", start, clock.Now())
+	}
+	clock.Advance(90 * time.Second)
+	if got := clock.Now().Sub(start); got != 90*time.Second {
+		t.Errorf("esperava avanço de 90s, obteve %v", got)
+	}
+	clock.Advance(-30 * time.Second)
+	if got := clock.Now().Sub(start); got != time.Minute {
+		t.Errorf("esperava 1min após retroceder, obteve %v", got)
+	}
+	clock.Set(start)
+	if !clock.Now().Equal(start) {
+		t.Errorf("Set não reposicionou o relógio: %v", clock.Now())
+	}
+}
@@ -0,0 +1,104 @@
+// Package signettest fornece dublês de teste para código que usa o pacote signet.
+package signettest
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrSimulatedFailure é o erro retornado pelo FakeSigner nas falhas programadas por FailNext.
+var ErrSimulatedFailure = errors.New("signettest: falha simulada do assinador")
+
+// FakeSigner é um dublê de assinador remoto (KMS/HSM) que delega a assinatura a um
+// crypto.Signer real, simulando latência de rede e falhas. Implementa signet.ContextSigner.
+// É seguro para uso concorrente.
+//
+// Exemplo:
+//
+//	signer := signettest.NewFakeSigner(privateKey)
+//	signer.Latency = 50 * time.Millisecond
+//	signer.FailNext(1)
+//	_, err := signet.NewPayload().SignWith(ctx, signer) // errors.Is(err, signet.ErrSignerFailed)
+type FakeSigner struct {
+	// Latency é o atraso simulado antes de cada assinatura. O cancelamento do contexto interrompe a espera.
+	Latency time.Duration
+	// Err, se não nulo, é retornado por todas as chamadas, inclusive as programadas por FailNext.
+	Err error
+	// Corrupt faz o assinador retornar uma assinatura adulterada, simulando um HSM defeituoso
+	// ou uma chave diferente da anunciada.
+	Corrupt bool
+
+	signer   crypto.Signer
+	mu       sync.Mutex
+	calls    int
+	failNext int
+}
+
+// NewFakeSigner cria um FakeSigner que assina com o signer fornecido.
+func NewFakeSigner(signer crypto.Signer) *FakeSigner {
+	return &FakeSigner{signer: signer}
+}
+
+// FailNext faz as próximas n chamadas falharem com ErrSimulatedFailure; as seguintes voltam
+// a assinar normalmente. Para que todas as chamadas falhem, defina Err, que tem precedência.
+func (f *FakeSigner) FailNext(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failNext = n
+}
+
+// Calls retorna o número de chamadas de assinatura recebidas.
+func (f *FakeSigner) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// Public retorna a chave pública do assinador real.
+func (f *FakeSigner) Public() crypto.PublicKey {
+	return f.signer.Public()
+}
+
+// Sign implementa crypto.Signer usando context.Background().
+func (f *FakeSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return f.SignContext(context.Background(), rand, digest, opts)
+}
+
+// SignContext implementa signet.ContextSigner.
+func (f *FakeSigner) SignContext(ctx context.Context, rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	f.mu.Lock()
+	f.calls++
+	fail := f.failNext > 0
+	if fail {
+		f.failNext--
+	}
+	f.mu.Unlock()
+
+	if f.Latency > 0 {
+		timer := time.NewTimer(f.Latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	if fail {
+		return nil, ErrSimulatedFailure
+	}
+	sig, err := f.signer.Sign(rand, digest, opts)
+	if err != nil {
+		return nil, err
+	}
+	if f.Corrupt && len(sig) > 0 {
+		sig[len(sig)-1] ^= 0xFF
+	}
+	return sig, nil
+}
@@ -0,0 +1,58 @@
+package signettest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFakeSigner_FailNextAndCalls(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	signer := NewFakeSigner(priv)
+	signer.FailNext(1)
+	if _, err := signer.Sign(nil, []byte("dados"), crypto.Hash(0)); !errors.Is(err, ErrSimulatedFailure) {
+		t.Errorf("esperava ErrSimulatedFailure, obteve: %v", err)
+	}
+	sig, err := signer.Sign(nil, []byte("dados"), crypto.Hash(0))
+	if err != nil {
+		t.Fatalf("segunda chamada deveria ter sucesso: %v", err)
+	}
+	if !ed25519.Verify(priv.Public().(ed25519.PublicKey), []byte("dados"), sig) {
+		t.Error("assinatura do FakeSigner deveria ser válida")
+	}
+	if signer.Calls() != 2 {
+		t.Errorf("esperava 2 chamadas, obteve %d", signer.Calls())
+	}
+}
+
+func TestFakeSigner_ErrFailsEveryCall(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	errKMS := errors.New("kms indisponível")
+	signer := NewFakeSigner(priv)
+	signer.Err = errKMS
+	signer.FailNext(1)
+	for i := 0; i < 3; i++ {
+		if _, err := signer.Sign(nil, []byte("dados"), crypto.Hash(0)); !errors.Is(err, errKMS) {
+			t.Errorf("chamada %d: esperava o erro configurado em Err, obteve: %v", i+1, err)
+		}
+	}
+}
+
+func TestFakeSigner_LatencyRespectsContext(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	signer := NewFakeSigner(priv)
+	signer.Latency = time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := signer.SignContext(ctx, nil, []byte("dados"), crypto.Hash(0))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("esperava context.DeadlineExceeded, obteve: %v", err)
+	}
+	if time.Since(start) >= time.Second {
+		t.Error("o cancelamento do contexto deveria interromper a latência simulada")
+	}
+}
@@ -0,0 +1,96 @@
+package signet
+
+import (
+	"context"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// ValidationError é o erro retornado pelas funções de validação (Parse, ParseWithResolver,
+// Validator.Validate, ParseString, OpenAndParse, ParseBatch, ...). Reúne a razão de métrica,
+// o claim que falhou e metadados não sensíveis do token, servindo de fonte única para logs,
+// métricas e camadas de transporte. Unwrap expõe o erro sentinela: errors.Is continua
+// funcionando como antes. A única exceção é ParseBatch, em que os tokens não processados por
+// cancelamento do contexto recebem ctx.Err() diretamente.
+//
+// NOTA DE SEGURANÇA: quando a falha ocorre antes da verificação da assinatura (ex: chave
+// desconhecida, assinatura inválida), os metadados vêm de um payload NÃO verificado e podem
+// ter sido forjados. Use-os apenas para diagnóstico, nunca para decisões de autorização.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver)
+//	var verr *signet.ValidationError
+//	if errors.As(err, &verr) {
+//	    log.Printf("token rejeitado: reason=%s claim=%s kid=%s sub=%s", verr.Reason, verr.Claim, verr.KeyID, verr.Subject)
+//	}
+type ValidationError struct {
+	// Reason é a razão registrada no MetricsRecorder (ex: ReasonTokenExpired).
+	Reason string
+	// Claim é o nome do claim que falhou (ex: "exp", "aud"), ou vazio se a falha não
+	// se refere a um claim (ex: assinatura, codificação).
+	Claim string
+	// KeyID é o kid do payload, se o payload pôde ser deserializado.
+	KeyID string
+	// Issuer é o iss do payload, se o payload pôde ser deserializado.
+	Issuer string
+	// Subject é o sub do payload, se o payload pôde ser deserializado.
+	Subject string
+	// IssuedAt é o iat do payload, se o payload pôde ser deserializado.
+	IssuedAt int64
+	// ExpiresAt é o exp do payload, se o payload pôde ser deserializado.
+	ExpiresAt int64
+	// Err é o erro subjacente, que envolve o erro sentinela correspondente.
+	Err error
+}
+
+// Error retorna a mensagem do erro subjacente.
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap retorna o erro subjacente, permitindo errors.Is com os erros sentinela.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// reasonClaims associa as razões de falha ao claim correspondente.
+var reasonClaims = map[string]string{
+	ReasonTokenExpired:         "exp",
+	ReasonTokenNotYetValid:     "iat",
+	ReasonTokenNotBefore:       "nbf",
+	ReasonTokenTooOld:          "iat",
+	ReasonLifetimeTooLong:      "exp",
+	ReasonIssuerMismatch:       "iss",
+	ReasonAudienceMismatch:     "aud",
+	ReasonMissingRequiredRole:  "roles",
+	ReasonTokenRevoked:         "sid",
+	ReasonTokenReplayed:        "jti",
+	ReasonReplayStoreError:     "jti",
+	ReasonExtensionMismatch:    "extension",
+	ReasonUnsupportedAlgorithm: "alg",
+	ReasonAlgorithmMismatch:    "alg",
+	ReasonHMACNotAllowed:       "alg",
+	ReasonUnsupportedVersion:   "version",
+}
+
+// newValidationError constrói o ValidationError com os metadados do payload, se disponível.
+func newValidationError(reason string, payload *signetv1.SignetPayload, err error) *ValidationError {
+	verr := &ValidationError{Reason: reason, Claim: reasonClaims[reason], Err: err}
+	if payload != nil {
+		verr.KeyID = payload.Kid
+		verr.Issuer = payload.Iss
+		verr.Subject = payload.Sub
+		verr.IssuedAt = payload.Iat
+		verr.ExpiresAt = payload.Exp
+	}
+	return verr
+}
+
+// fail registra a métrica de falha e retorna o erro como *ValidationError.
+func (c *validationConfig) fail(ctx context.Context, reason string, payload *signetv1.SignetPayload, err error) error {
+	if c.metricsRecorder != nil {
+		c.metricsRecorder.IncrementTokenValidation(ctx, false, reason)
+	}
+	return newValidationError(reason, payload, err)
+}
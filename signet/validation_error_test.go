@@ -0,0 +1,87 @@
+package signet
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Testa a razão, o claim e os metadados expostos pelo ValidationError
+func TestValidationError(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	iat := time.Now().Add(-time.Hour).Unix()
+	expired, _ := NewPayload().WithSubject("user-123").WithKeyID("v1").WithIssuer("auth").
+		WithIssuedAt(iat).WithExpiration(iat + 60).Sign(priv)
+	valid, _ := NewPayload().WithSubject("user-123").WithKeyID("v1").WithAudience("api").Sign(priv)
+	_, otherKey, _ := ed25519.GenerateKey(nil)
+	forged, _ := NewPayload().WithSubject("admin").WithKeyID("v1").Sign(otherKey)
+
+	testCases := []struct {
+		name           string
+		token          []byte
+		options        []ValidationOption
+		expectedError  error
+		expectedReason string
+		expectedClaim  string
+		expectedSub    string
+	}{
+		{"Token expirado", expired, nil, ErrTokenExpired, ReasonTokenExpired, "exp", "user-123"},
+		{"Emissor fora da lista", expired, []ValidationOption{WithIssuer("outro")}, ErrIssuerMismatch, ReasonIssuerMismatch, "iss", "user-123"},
+		{"Audiência incorreta", valid, []ValidationOption{WithAudience("outra")}, ErrAudienceMismatch, ReasonAudienceMismatch, "aud", "user-123"},
+		{"Versão não aceita (erro envolvido)", valid, []ValidationOption{WithAcceptedVersions(2)}, ErrUnsupportedVersion, ReasonUnsupportedVersion, "version", ""},
+		{"Assinatura inválida com metadados não verificados", forged, nil, ErrInvalidSignature, ReasonInvalidSignature, "", "admin"},
+		{"Token corrompido", []byte{0xFF, 0xFF}, nil, ErrInvalidPayload, ReasonInvalidPayload, "", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("esperava *ValidationError, obteve %T", err)
+			}
+			if verr.Reason != tc.expectedReason || verr.Claim != tc.expectedClaim || verr.Subject != tc.expectedSub {
+				t.Errorf("esperava reason=%q claim=%q sub=%q, obteve reason=%q claim=%q sub=%q",
+					tc.expectedReason, tc.expectedClaim, tc.expectedSub, verr.Reason, verr.Claim, verr.Subject)
+			}
+			if verr.Error() != verr.Err.Error() {
+				t.Errorf("mensagem do erro alterada: %q", verr.Error())
+			}
+		})
+	}
+
+	// Metadados do payload deserializado
+	_, err := Parse(context.Background(), expired, keyResolver)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || (verr.KeyID != "v1" || verr.Issuer != "auth" || verr.IssuedAt != iat || verr.ExpiresAt != iat+60) {
+		t.Errorf("metadados inesperados: %+v", verr)
+	}
+}
+
+// Testa que as falhas anteriores ao Parse também retornam *ValidationError
+func TestValidationError_EncodingAndSealed(t *testing.T) {
+	pub, _, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	recipient, _ := ecdh.X25519().GenerateKey(rand.Reader)
+
+	var verr *ValidationError
+	_, err := ParseString(context.Background(), "sem-prefixo", keyResolver)
+	if !errors.As(err, &verr) || verr.Reason != ReasonInvalidTokenEncoding || !errors.Is(err, ErrInvalidTokenEncoding) {
+		t.Errorf("esperava ValidationError com razão %q, obteve: %v", ReasonInvalidTokenEncoding, err)
+	}
+	_, err = OpenAndParse(context.Background(), []byte{0xFF}, recipient, KeyResolverFunc(keyResolver))
+	if !errors.As(err, &verr) || verr.Reason != ReasonInvalidPayload {
+		t.Errorf("esperava ValidationError com razão %q, obteve: %v", ReasonInvalidPayload, err)
+	}
+}
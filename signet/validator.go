@@ -0,0 +1,118 @@
+package signet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Validator é um validador de tokens com configuração pré-compilada: as opções são aplicadas
+// e verificadas uma única vez em NewValidator, e cada chamada a Validate reutiliza a mesma
+// configuração imutável. Diferentemente das funções Parse*, a decodificação estrita
+// (WithStrictEncoding) é o padrão. É seguro para uso concorrente e indicado para servidores, onde
+// erros de configuração devem aparecer na inicialização e não a cada requisição.
+//
+// Exemplo:
+//
+//	validator, err := signet.NewValidator(resolver,
+//	    signet.WithAudience("api-backend"),
+//	    signet.WithIssuer("auth.exemplo.com"))
+//	if err != nil {
+//	    log.Fatalf("configuração Signet inválida: %v", err)
+//	}
+//	payload, err := validator.Validate(ctx, tokenBytes)
+type Validator struct {
+	keyResolver KeyResolver
+	config      *validationConfig
+}
+
+// NewValidator aplica as opções e valida a configuração resultante, retornando um erro que
+// envolve ErrInvalidConfig para configurações vazias ou contraditórias, por exemplo:
+// KeyResolver ou resolvedor de segredos HMAC nulos, audiência vazia, WithIssuer ou
+// WithAcceptedVersions sem valores, papel requerido vazio, tolerância de relógio, idade ou
+// validade máxima negativas, WithMaxAge combinado com WithSkipIssuedAtCheck, limiar de
+// assinaturas menor que 1 ou maior que a lista de kids confiáveis, RequireSignatures
+// combinado com WithHybridPolicy, AllowHMAC combinado com HybridRequireBoth, ou
+// WithReplayProtection combinado com WithSkipExpirationCheck.
+func NewValidator(keyResolver KeyResolver, options ...ValidationOption) (*Validator, error) {
+	if isNilKeyResolver(keyResolver) {
+		return nil, fmt.Errorf("KeyResolver ausente: %w", ErrInvalidConfig)
+	}
+	config := newValidationConfig(append([]ValidationOption{WithStrictEncoding()}, options...))
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &Validator{keyResolver: keyResolver, config: config}, nil
+}
+
+// isNilKeyResolver detecta resolvedores nulos, inclusive funções nulas convertidas para a
+// interface, que seriam não nulas na comparação direta e entrariam em pânico ao serem chamadas.
+func isNilKeyResolver(keyResolver KeyResolver) bool {
+	switch fn := keyResolver.(type) {
+	case nil:
+		return true
+	case KeyResolverFunc:
+		return fn == nil
+	case VerificationKeyResolverFunc:
+		return fn == nil
+	case ECDSAKeyResolverFunc:
+		return fn == nil
+	case MLDSA65KeyResolverFunc:
+		return fn == nil
+	case IssuerKeyResolverFunc:
+		return fn == nil
+	}
+	return false
+}
+
+// Validate deserializa e valida o token com a configuração do Validator, exatamente como
+// ParseWithResolver. Seguro para uso concorrente.
+//
+// Exemplo:
+//
+//	payload, err := validator.Validate(ctx, tokenBytes)
+func (v *Validator) Validate(ctx context.Context, tokenBytes []byte) (*signetv1.SignetPayload, error) {
+	return parseToken(ctx, tokenBytes, v.keyResolver, v.config)
+}
+
+// validate rejeita configurações vazias ou contraditórias.
+func (c *validationConfig) validate() error {
+	switch {
+	case c.audienceSet && c.expectedAudience == "":
+		return fmt.Errorf("audiência esperada vazia: %w", ErrInvalidConfig)
+	case c.allowedIssuers != nil && len(c.allowedIssuers) == 0:
+		return fmt.Errorf("WithIssuer sem emissores rejeitaria todos os tokens: %w", ErrInvalidConfig)
+	case c.acceptedVersions != nil && len(c.acceptedVersions) == 0:
+		return fmt.Errorf("WithAcceptedVersions sem versões rejeitaria todos os tokens: %w", ErrInvalidConfig)
+	case c.thresholdSet && c.requiredSignatures < 1:
+		return fmt.Errorf("limiar de %d assinaturas deve ser ao menos 1: %w", c.requiredSignatures, ErrInvalidConfig)
+	case c.trustedKids != nil && c.requiredSignatures > len(c.trustedKids):
+		return fmt.Errorf("limiar de %d assinaturas com apenas %d kids confiáveis: %w", c.requiredSignatures, len(c.trustedKids), ErrInvalidConfig)
+	case c.thresholdSet && c.hybridPolicy != HybridClassicalOnly:
+		return fmt.Errorf("RequireSignatures e WithHybridPolicy são mutuamente exclusivos: %w", ErrInvalidConfig)
+	case c.hmacSet && c.secretResolver == nil:
+		return fmt.Errorf("AllowHMAC sem resolvedor de segredos: %w", ErrInvalidConfig)
+	case c.hmacSet && c.hybridPolicy == HybridRequireBoth:
+		return fmt.Errorf("AllowHMAC e HybridRequireBoth são mutuamente exclusivos: %w", ErrInvalidConfig)
+	case c.hybridPolicy < HybridClassicalOnly || c.hybridPolicy > HybridRequireBoth:
+		return fmt.Errorf("política híbrida %d desconhecida: %w", c.hybridPolicy, ErrInvalidConfig)
+	case c.replayStore != nil && c.skipExpirationCheck:
+		return fmt.Errorf("WithReplayProtection exige a verificação de expiração: %w", ErrInvalidConfig)
+	case c.leeway < 0:
+		return fmt.Errorf("tolerância de relógio negativa: %w", ErrInvalidConfig)
+	case c.maxAge < 0 || c.maxLifetime < 0:
+		return fmt.Errorf("idade ou validade máxima negativa: %w", ErrInvalidConfig)
+	case c.maxAge > 0 && c.skipIssuedAtCheck:
+		return fmt.Errorf("WithMaxAge exige a verificação de iat: %w", ErrInvalidConfig)
+	case len(c.signingContext) > core.MaxSigningContextSize:
+		return fmt.Errorf("contexto de assinatura excede %d bytes: %w", core.MaxSigningContextSize, ErrInvalidConfig)
+	}
+	for _, role := range c.requiredRoles {
+		if role == "" {
+			return fmt.Errorf("papel requerido vazio: %w", ErrInvalidConfig)
+		}
+	}
+	return nil
+}
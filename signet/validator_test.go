@@ -0,0 +1,108 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Testa a rejeição de configurações vazias ou contraditórias na construção do Validator
+func TestNewValidator_InvalidConfig(t *testing.T) {
+	pub, _, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	testCases := []struct {
+		name     string
+		resolver KeyResolver
+		options  []ValidationOption
+	}{
+		{"KeyResolver nulo", nil, nil},
+		{"KeyResolverFunc nula", KeyResolverFunc(nil), nil},
+		{"VerificationKeyResolverFunc nula", VerificationKeyResolverFunc(nil), nil},
+		{"ECDSAKeyResolverFunc nula", ECDSAKeyResolverFunc(nil), nil},
+		{"MLDSA65KeyResolverFunc nula", MLDSA65KeyResolverFunc(nil), nil},
+		{"IssuerKeyResolverFunc nula", IssuerKeyResolverFunc(nil), nil},
+		{"AllowHMAC nulo", resolver, []ValidationOption{AllowHMAC(nil)}},
+		{"AllowHMACWithResolver nulo", resolver, []ValidationOption{AllowHMACWithResolver(nil)}},
+		{"IssuerSecretResolverFunc nula", resolver, []ValidationOption{AllowHMACWithResolver(IssuerSecretResolverFunc(nil))}},
+		{"SecretResolverFunc nula", resolver, []ValidationOption{AllowHMACWithResolver(SecretResolverFunc(nil))}},
+		{"Audiência vazia", resolver, []ValidationOption{WithAudience("")}},
+		{"WithIssuer sem emissores", resolver, []ValidationOption{WithIssuer()}},
+		{"WithAcceptedVersions sem versões", resolver, []ValidationOption{WithAcceptedVersions()}},
+		{"Papel requerido vazio", resolver, []ValidationOption{RequireRoles("admin", "")}},
+		{"Limiar zero", resolver, []ValidationOption{RequireSignatures(0)}},
+		{"Limiar negativo", resolver, []ValidationOption{RequireSignatures(-1, "a")}},
+		{"Limiar maior que os kids confiáveis", resolver, []ValidationOption{RequireSignatures(3, "a", "b")}},
+		{"Limiar com política híbrida", resolver, []ValidationOption{RequireSignatures(2), WithHybridPolicy(HybridRequireBoth)}},
+		{"HMAC com política híbrida estrita", resolver, []ValidationOption{AllowHMAC(func(ctx context.Context, kid string) ([]byte, error) { return nil, nil }), WithHybridPolicy(HybridRequireBoth)}},
+		{"Política híbrida desconhecida", resolver, []ValidationOption{WithHybridPolicy(HybridPolicy(42))}},
+		{"Replay sem verificação de expiração", resolver, []ValidationOption{WithReplayProtection(NewMemoryReplayStore()), WithSkipExpirationCheck()}},
+		{"Tolerância de relógio negativa", resolver, []ValidationOption{WithLeeway(-time.Second)}},
+		{"Idade máxima negativa", resolver, []ValidationOption{WithMaxAge(-time.Minute)}},
+		{"Idade máxima sem verificação de iat", resolver, []ValidationOption{WithMaxAge(time.Hour), WithSkipIssuedAtCheck()}},
+		{"Contexto de assinatura longo demais", resolver, []ValidationOption{WithSigningContext(strings.Repeat("x", 256))}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := NewValidator(tc.resolver, tc.options...)
+			if !errors.Is(err, ErrInvalidConfig) || v != nil {
+				t.Errorf("esperava ErrInvalidConfig, obteve: %v", err)
+			}
+		})
+	}
+}
+
+// Testa a validação com configuração pré-compilada e o uso concorrente do Validator
+func TestValidator_Validate(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	resolver := KeyResolverFunc(func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	})
+	recorder := &countingRecorder{}
+	validator, err := NewValidator(resolver,
+		WithAudience("api"),
+		RequireRole("admin"),
+		WithReplayProtection(NewMemoryReplayStore()),
+		WithMetricsRecorder(recorder))
+	if err != nil {
+		t.Fatalf("configuração válida rejeitada: %v", err)
+	}
+
+	const tokens = 50
+	valid := make([][]byte, tokens)
+	for i := range valid {
+		valid[i], _ = NewPayload().WithAudience("api").WithRole("admin").Sign(priv)
+	}
+	wrongAudience, _ := NewPayload().WithAudience("outra").WithRole("admin").Sign(priv)
+
+	var wg sync.WaitGroup
+	errs := make([]error, tokens)
+	for i := range valid {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = validator.Validate(context.Background(), valid[i])
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("token %d rejeitado: %v", i, err)
+		}
+	}
+
+	if _, err := validator.Validate(context.Background(), wrongAudience); !errors.Is(err, ErrAudienceMismatch) {
+		t.Errorf("esperava ErrAudienceMismatch, obteve: %v", err)
+	}
+	if _, err := validator.Validate(context.Background(), valid[0]); !errors.Is(err, ErrTokenReplayed) {
+		t.Errorf("esperava ErrTokenReplayed, obteve: %v", err)
+	}
+	if recorder.reasons[ReasonSuccess] != tokens {
+		t.Errorf("esperava %d sucessos, obteve %v", tokens, recorder.reasons)
+	}
+}
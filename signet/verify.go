@@ -0,0 +1,132 @@
+package signet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lucas-de-lima/signet-go/internal/core"
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// verifyToken verifica as assinaturas do token de acordo com a política de limiar ou híbrida configurada,
+// sobre a mensagem vinculada à versão declarada pelo token e ao contexto de assinatura exigido pelo validador.
+// No modo estrito, exige ainda que todas as assinaturas adicionais sejam válidas (ver verifyAdditionalSignatures).
+// Retorna a razão de métrica e o erro sentinela contextualizado em caso de falha.
+func verifyToken(ctx context.Context, token *signetv1.SignetToken, payload *signetv1.SignetPayload, keyResolver KeyResolver, config *validationConfig) (string, error) {
+	ref := KeyRequest{KeyID: payload.Kid, Issuer: payload.Iss}
+	message, err := core.SigningMessage(token.Version, config.signingContext, token.Payload)
+	if err != nil {
+		return ReasonInvalidSignature, fmt.Errorf("contexto de assinatura: %w", ErrInvalidSigningContext)
+	}
+	if reason, err := verifyPolicy(ctx, token, message, ref, keyResolver, config); err != nil {
+		return reason, err
+	}
+	if config.strictEncoding {
+		return verifyAdditionalSignatures(ctx, token, message, ref, keyResolver)
+	}
+	return "", nil
+}
+
+// verifyAdditionalSignatures exige que cada assinatura adicional seja válida e de um par
+// (kid, algoritmo) distinto. O campo signatures não é coberto pela assinatura principal:
+// sem esta verificação, entradas ignoradas pela política ativa (ex: anexadas a um token
+// clássico) permitiriam gerar incontáveis codificações canônicas do mesmo token.
+func verifyAdditionalSignatures(ctx context.Context, token *signetv1.SignetToken, message []byte, ref KeyRequest, keyResolver KeyResolver) (string, error) {
+	signers := map[string]struct{}{tokenAlgorithm(token.Alg) + "\x00" + ref.KeyID: {}}
+	for i, sig := range token.Signatures {
+		signerRef := ref
+		signerRef.KeyID = signatureKeyID(sig, ref.KeyID)
+		signer := sig.Alg + "\x00" + signerRef.KeyID
+		if _, dup := signers[signer]; dup {
+			return ReasonNonCanonicalEncoding, fmt.Errorf("assinatura adicional %d repete kid '%s' e algoritmo '%s': %w", i, signerRef.KeyID, sig.Alg, ErrNonCanonicalEncoding)
+		}
+		signers[signer] = struct{}{}
+		if _, err := verifySignature(ctx, keyResolver, signerRef, sig.Alg, message, sig.Signature); err != nil {
+			return ReasonNonCanonicalEncoding, fmt.Errorf("assinatura adicional %d não verificada (%v): %w", i, err, ErrNonCanonicalEncoding)
+		}
+	}
+	return "", nil
+}
+
+// verifyPolicy verifica as assinaturas exigidas pela política de limiar ou híbrida configurada.
+func verifyPolicy(ctx context.Context, token *signetv1.SignetToken, message []byte, ref KeyRequest, keyResolver KeyResolver, config *validationConfig) (string, error) {
+	if config.thresholdSet {
+		return verifyThreshold(ctx, token, message, ref, keyResolver, config)
+	}
+	primaryAlg := tokenAlgorithm(token.Alg)
+	if primaryAlg == AlgHS256 {
+		if config.secretResolver == nil {
+			return ReasonHMACNotAllowed, ErrHMACNotAllowed
+		}
+		// Tokens HMAC não carregam assinatura pós-quântica
+		if config.hybridPolicy == HybridRequireBoth {
+			return ReasonHybridPolicyViolation, fmt.Errorf("assinatura principal '%s' não é clássica assimétrica: %w", primaryAlg, ErrHybridSignatureRequired)
+		}
+		return verifyHMAC(ctx, config.secretResolver, ref, message, token.Signature)
+	}
+	switch config.hybridPolicy {
+	case HybridRequireBoth:
+		if isPostQuantum(primaryAlg) {
+			return ReasonHybridPolicyViolation, fmt.Errorf("assinatura principal '%s' não é clássica: %w", primaryAlg, ErrHybridSignatureRequired)
+		}
+		if reason, err := verifySignature(ctx, keyResolver, ref, primaryAlg, message, token.Signature); err != nil {
+			return reason, err
+		}
+		return verifyPostQuantumSignature(ctx, token, message, ref, keyResolver)
+	case HybridAcceptEither:
+		reason, err := verifySignature(ctx, keyResolver, ref, primaryAlg, message, token.Signature)
+		if err == nil {
+			return "", nil
+		}
+		if _, pqErr := verifyPostQuantumSignature(ctx, token, message, ref, keyResolver); pqErr == nil {
+			return "", nil
+		}
+		return reason, err
+	default:
+		return verifySignature(ctx, keyResolver, ref, primaryAlg, message, token.Signature)
+	}
+}
+
+// verifyPostQuantumSignature verifica a assinatura pós-quântica adicional de um token híbrido.
+func verifyPostQuantumSignature(ctx context.Context, token *signetv1.SignetToken, message []byte, ref KeyRequest, keyResolver KeyResolver) (string, error) {
+	for _, sig := range token.Signatures {
+		if isPostQuantum(sig.Alg) {
+			return verifySignature(ctx, keyResolver, ref, sig.Alg, message, sig.Signature)
+		}
+	}
+	return ReasonHybridPolicyViolation, ErrHybridSignatureRequired
+}
+
+// verifySignature resolve a chave para (iss, kid, alg) e verifica uma única assinatura assimétrica,
+// recusando chaves registradas para outro algoritmo.
+func verifySignature(ctx context.Context, keyResolver KeyResolver, ref KeyRequest, alg string, data, signature []byte) (string, error) {
+	_, reason, err := verifySignatureKey(ctx, keyResolver, ref, alg, data, signature)
+	return reason, err
+}
+
+// verifySignatureKey é como verifySignature, mas também retorna a chave que validou a assinatura.
+func verifySignatureKey(ctx context.Context, keyResolver KeyResolver, ref KeyRequest, alg string, data, signature []byte) (VerificationKey, string, error) {
+	// Segredos HMAC nunca são resolvidos via KeyResolver (ver AllowHMAC)
+	if alg == AlgHS256 {
+		return VerificationKey{}, ReasonHMACNotAllowed, ErrHMACNotAllowed
+	}
+	if _, err := core.DefaultRegistry().Lookup(alg); err != nil {
+		return VerificationKey{}, ReasonUnsupportedAlgorithm, fmt.Errorf("algoritmo '%s': %w", alg, ErrUnsupportedAlgorithm)
+	}
+	ref.Algorithm = alg
+	key, err := keyResolver.ResolveKey(ctx, ref)
+	if err != nil {
+		return VerificationKey{}, ReasonInvalidSignature, fmt.Errorf("falha ao resolver chave pública para kid '%s': %w", ref.KeyID, err)
+	}
+	if err := core.DefaultRegistry().Verify(alg, key.Algorithm, key.Key, data, signature); err != nil {
+		switch {
+		case errors.Is(err, core.ErrVerificationFailed):
+			return VerificationKey{}, ReasonInvalidSignature, fmt.Errorf("falha na verificação criptográfica do núcleo: %w", ErrInvalidSignature)
+		case errors.Is(err, core.ErrAlgorithmMismatch):
+			return VerificationKey{}, ReasonAlgorithmMismatch, fmt.Errorf("token declara '%s', chave registrada para '%s': %w", alg, key.Algorithm, ErrAlgorithmMismatch)
+		}
+		return VerificationKey{}, ReasonInvalidSignature, fmt.Errorf("falha na verificação criptográfica do núcleo: %w", err)
+	}
+	return key, "", nil
+}
@@ -0,0 +1,52 @@
+package signet
+
+import (
+	"fmt"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+const (
+	// TokenVersion1 é a versão 1 do formato do SignetToken, descrita em proto/v1/spec.proto.
+	TokenVersion1 uint32 = 1
+	// CurrentTokenVersion é a versão emitida pelo PayloadBuilder.
+	CurrentTokenVersion = TokenVersion1
+)
+
+// WithAcceptedVersions restringe as versões do formato do token aceitas pelo Parse.
+// Sem esta opção, todas as versões suportadas pela biblioteca são aceitas. Versões
+// não suportadas pela biblioteca são rejeitadas mesmo que listadas aqui.
+// Tokens legados, sem o campo version, são tratados como versão 1.
+//
+// Exemplo:
+//
+//	payload, err := signet.Parse(ctx, tokenBytes, keyResolver, signet.WithAcceptedVersions(1, 2))
+func WithAcceptedVersions(versions ...uint32) ValidationOption {
+	return func(c *validationConfig) {
+		c.acceptedVersions = make(map[uint32]struct{}, len(versions))
+		for _, v := range versions {
+			c.acceptedVersions[v] = struct{}{}
+		}
+	}
+}
+
+// tokenVersion retorna a versão declarada pelo token; tokens legados, sem versão, são v1.
+// A versão declarada é vinculada à assinatura (ver core.SigningMessage), de modo que
+// um token não pode ser reapresentado sob outra versão.
+func tokenVersion(token *signetv1.SignetToken) uint32 {
+	if token.Version == 0 {
+		return TokenVersion1
+	}
+	return token.Version
+}
+
+// checkVersion rejeita versões fora da lista configurada em WithAcceptedVersions.
+func checkVersion(version uint32, config *validationConfig) (string, error) {
+	if config.acceptedVersions == nil {
+		return "", nil
+	}
+	if _, ok := config.acceptedVersions[version]; !ok {
+		return ReasonUnsupportedVersion, fmt.Errorf("versão %d não aceita pela configuração: %w", version, ErrUnsupportedVersion)
+	}
+	return "", nil
+}
@@ -0,0 +1,67 @@
+package signet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	signetv1 "github.com/lucas-de-lima/signet-go/proto/v1"
+)
+
+// Testa o despacho pela versão do formato e a restrição de versões aceitas
+func TestParse_TokenVersion(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	keyResolver := func(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+		return pub, nil
+	}
+	current, err := NewPayload().Sign(priv)
+	if err != nil {
+		t.Fatalf("erro ao assinar: %v", err)
+	}
+	var token signetv1.SignetToken
+	_ = proto.Unmarshal(current, &token)
+	if token.Version != CurrentTokenVersion {
+		t.Fatalf("esperava versão %d no token emitido, obteve %d", CurrentTokenVersion, token.Version)
+	}
+	// Token legado (v1.0): sem o campo version e assinado sobre os bytes do payload
+	legacy := corruptToken(t, current, func(tok *signetv1.SignetToken) {
+		tok.Version = 0
+		tok.Signature = ed25519.Sign(priv, tok.Payload)
+	})
+	future := corruptToken(t, current, func(tok *signetv1.SignetToken) { tok.Version = 2 })
+	// Reclassificações: a versão é vinculada à assinatura e não pode ser trocada
+	downgraded := corruptToken(t, current, func(tok *signetv1.SignetToken) { tok.Version = 0 })
+	upgraded := corruptToken(t, legacy, func(tok *signetv1.SignetToken) { tok.Version = TokenVersion1 })
+
+	recorder := &countingRecorder{}
+	testCases := []struct {
+		name          string
+		token         []byte
+		options       []ValidationOption
+		expectedError error
+	}{
+		{"Sucesso: versão atual", current, nil, nil},
+		{"Sucesso: token sem versão tratado como v1", legacy, nil, nil},
+		{"Sucesso: token sem versão com v1 aceita", legacy, []ValidationOption{WithAcceptedVersions(1)}, nil},
+		{"Sucesso: versão listada entre várias", current, []ValidationOption{WithAcceptedVersions(1, 2)}, nil},
+		{"Falha: versão removida de token v1", downgraded, nil, ErrInvalidSignature},
+		{"Falha: versão 1 declarada em token legado", upgraded, nil, ErrInvalidSignature},
+		{"Falha: versão desconhecida", future, []ValidationOption{WithMetricsRecorder(recorder)}, ErrUnsupportedVersion},
+		{"Falha: versão desconhecida mesmo se aceita", future, []ValidationOption{WithAcceptedVersions(1, 2)}, ErrUnsupportedVersion},
+		{"Falha: versão fora da lista aceita", current, []ValidationOption{WithAcceptedVersions(2), WithMetricsRecorder(recorder)}, ErrUnsupportedVersion},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(context.Background(), tc.token, keyResolver, tc.options...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("esperava erro '%v', mas obteve '%v'", tc.expectedError, err)
+			}
+		})
+	}
+	if recorder.reasons[ReasonUnsupportedVersion] != 2 {
+		t.Errorf("esperava 2 métricas %q, obteve %v", ReasonUnsupportedVersion, recorder.reasons)
+	}
+}